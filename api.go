@@ -140,6 +140,7 @@ type contextKey int
 const (
 	_ctxKeyFailFast contextKey = iota
 	_ctxKeySkipTypedRules
+	_ctxKeyEncodeMode
 )
 
 // WithFailFast returns a child context that marks fail-fast parsing behavior.
@@ -169,6 +170,19 @@ func IsSkipTypedRules(ctx context.Context) bool {
 	return b
 }
 
+// WithEncodeMode marks the context with the output intent for WireEncoder implementations.
+// EncodePreservingToMap sets EncodePreserve, under which object schemas do not emit the static
+// defaults of missing fields.
+func WithEncodeMode(ctx context.Context, mode EncodeMode) context.Context {
+	return context.WithValue(ctx, _ctxKeyEncodeMode, mode)
+}
+
+// EncodeModeFrom reports the output intent set by WithEncodeMode (EncodeCanonical by default).
+func EncodeModeFrom(ctx context.Context) EncodeMode {
+	m, _ := ctx.Value(_ctxKeyEncodeMode).(EncodeMode)
+	return m
+}
+
 // ---- Streaming SPI (internal) ----
 
 // ErrStreamingUnsupported indicates a Schema does not yet support streaming driver path.
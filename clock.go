@@ -0,0 +1,61 @@
+package goskema
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Parse-time sources of the current time and of generated IDs. Computed defaults (DefaultFunc)
+// should read them through Now/NewID so that tests can pin them with WithClock/WithIDFunc.
+
+type clockKey struct{}
+
+type idFuncKey struct{}
+
+// WithClock returns a child context whose Now reports now() instead of time.Now().
+//
+//	ctx = goskema.WithClock(ctx, func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) })
+func WithClock(ctx context.Context, now func() time.Time) context.Context {
+	return context.WithValue(ctx, clockKey{}, now)
+}
+
+// Now returns the current time of ctx (see WithClock), falling back to time.Now().
+func Now(ctx context.Context) time.Time {
+	if now, ok := ctx.Value(clockKey{}).(func() time.Time); ok && now != nil {
+		return now()
+	}
+	return time.Now()
+}
+
+// WithIDFunc returns a child context whose NewID calls next instead of generating a random UUID.
+func WithIDFunc(ctx context.Context, next func() string) context.Context {
+	return context.WithValue(ctx, idFuncKey{}, next)
+}
+
+// NewID returns a new identifier for ctx (see WithIDFunc), falling back to a random UUID v4.
+func NewID(ctx context.Context) string {
+	if next, ok := ctx.Value(idFuncKey{}).(func() string); ok && next != nil {
+		return next()
+	}
+	return randomUUID()
+}
+
+func randomUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	var out [36]byte
+	hex.Encode(out[0:8], b[0:4])
+	out[8] = '-'
+	hex.Encode(out[9:13], b[4:6])
+	out[13] = '-'
+	hex.Encode(out[14:18], b[6:8])
+	out[18] = '-'
+	hex.Encode(out[19:23], b[8:10])
+	out[23] = '-'
+	hex.Encode(out[24:], b[10:])
+	return string(out[:])
+}
@@ -0,0 +1,25 @@
+package codec
+
+import (
+	"encoding/base64"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// Base64 returns a Codec that converts between standard (padded) base64 strings and []byte.
+// JSON Schema: {type: string, contentEncoding: base64}.
+func Base64() goskema.Codec[string, []byte] {
+	return stringCodec(js.Schema{ContentEncoding: "base64"}, "base64", base64.StdEncoding.DecodeString,
+		func(b []byte) (string, error) { return base64.StdEncoding.EncodeToString(b), nil })
+}
+
+// Base64URL returns a Codec that converts between URL-safe base64 strings and []byte.
+// Decode accepts input with or without padding; Encode emits the unpadded form (RFC 7515).
+// JSON Schema: {type: string, contentEncoding: base64url}.
+func Base64URL() goskema.Codec[string, []byte] {
+	return stringCodec(js.Schema{ContentEncoding: "base64url"}, "base64url",
+		func(s string) ([]byte, error) { return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "=")) },
+		func(b []byte) (string, error) { return base64.RawURLEncoding.EncodeToString(b), nil })
+}
@@ -0,0 +1,102 @@
+package codec
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+const (
+	bigIntPattern  = `^-?[0-9]+$`
+	decimalPattern = `^-?[0-9]+(\.[0-9]+)?$`
+)
+
+// BigInt returns a Codec that converts between decimal integer strings and *big.Int, for
+// values that do not fit in a JSON number without losing precision.
+// JSON Schema: {type: string, pattern: ^-?[0-9]+$}.
+func BigInt() goskema.Codec[string, *big.Int] {
+	return stringCodec(js.Schema{Pattern: bigIntPattern}, "integer string",
+		func(s string) (*big.Int, error) {
+			if !isDecimal(s, false) {
+				return nil, errors.New("expected decimal digits")
+			}
+			n, _ := new(big.Int).SetString(s, 10)
+			return n, nil
+		},
+		func(n *big.Int) (string, error) {
+			if n == nil {
+				return "", errors.New("nil *big.Int")
+			}
+			return n.String(), nil
+		})
+}
+
+// BigRat returns a Codec that converts between decimal strings ("12.50") and *big.Rat.
+// Fractions and exponents are not accepted on the wire. Encode emits the exact decimal form
+// and fails for values without one (e.g. 1/3).
+// JSON Schema: {type: string, pattern: ^-?[0-9]+(\.[0-9]+)?$}.
+func BigRat() goskema.Codec[string, *big.Rat] {
+	return stringCodec(js.Schema{Pattern: decimalPattern}, "decimal string",
+		func(s string) (*big.Rat, error) {
+			if !isDecimal(s, true) {
+				return nil, errors.New("expected a decimal number such as 12.50")
+			}
+			r, _ := new(big.Rat).SetString(s)
+			return r, nil
+		},
+		formatExactDecimal)
+}
+
+// isDecimal reports whether s matches -?[0-9]+ (with frac, optionally followed by .[0-9]+).
+func isDecimal(s string, frac bool) bool {
+	s = strings.TrimPrefix(s, "-")
+	whole, rest, dot := strings.Cut(s, ".")
+	if dot && (!frac || !allDigits(rest)) {
+		return false
+	}
+	return allDigits(whole)
+}
+
+func allDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// formatExactDecimal renders r as a terminating decimal. Only denominators of the form 2^a*5^b
+// have one; the number of fractional digits is max(a, b).
+func formatExactDecimal(r *big.Rat) (string, error) {
+	if r == nil {
+		return "", errors.New("nil *big.Rat")
+	}
+	if r.IsInt() {
+		return r.Num().String(), nil
+	}
+	d := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	var m big.Int
+	count := func(p *big.Int) int {
+		n := 0
+		for {
+			q, _ := new(big.Int).QuoRem(d, p, &m)
+			if m.Sign() != 0 {
+				return n
+			}
+			d, n = q, n+1
+		}
+	}
+	a, b := count(two), count(five)
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return "", errors.New(r.RatString() + " has no exact decimal representation")
+	}
+	return r.FloatString(max(a, b)), nil
+}
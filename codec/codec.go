@@ -0,0 +1,179 @@
+package codec
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// funcCodec is the Codec behind the built-in conversions: decode/encode convert between the
+// wire value A and the domain value B. Failures that are not Issues are reported as
+// invalid_format with hint.
+type funcCodec[A, B any] struct {
+	in     goskema.Schema[A]
+	out    goskema.Schema[B]
+	hint   string
+	decode func(A) (B, error)
+	encode func(B) (A, error)
+}
+
+// newFuncCodec builds a codec whose In and Out schemas both export wire: Out().JSONSchema()
+// is what dsl.Codec publishes for a field, and the field's JSON form is the wire form.
+func newFuncCodec[A, B any](in goskema.Schema[A], wire js.Schema, hint string, decode func(A) (B, error), encode func(B) (A, error)) goskema.Codec[A, B] {
+	return &funcCodec[A, B]{in: in, out: valueSchema[B]{js: wire}, hint: hint, decode: decode, encode: encode}
+}
+
+func (c *funcCodec[A, B]) In() goskema.Schema[A]  { return c.in }
+func (c *funcCodec[A, B]) Out() goskema.Schema[B] { return c.out }
+
+func (c *funcCodec[A, B]) Decode(ctx context.Context, a A) (B, error) {
+	var zero B
+	b, err := c.decode(a)
+	if err != nil {
+		return zero, c.issues(err)
+	}
+	if err := c.out.ValidateValue(ctx, b); err != nil {
+		return zero, err
+	}
+	return b, nil
+}
+
+func (c *funcCodec[A, B]) Encode(ctx context.Context, b B) (A, error) {
+	var zero A
+	if err := c.out.ValidateValue(ctx, b); err != nil {
+		return zero, err
+	}
+	a, err := c.encode(b)
+	if err != nil {
+		return zero, c.issues(err)
+	}
+	if _, err := c.in.Parse(ctx, a); err != nil {
+		return zero, err
+	}
+	return a, nil
+}
+
+func (c *funcCodec[A, B]) DecodeWithMeta(ctx context.Context, a A) (goskema.Decoded[B], error) {
+	b, err := c.Decode(ctx, a)
+	return goskema.Decoded[B]{Value: b, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+
+func (c *funcCodec[A, B]) EncodePreserving(ctx context.Context, db goskema.Decoded[B]) (A, error) {
+	// Top-level scalars cannot represent null/missing, so treat those cases as errors.
+	if p, ok := db.Presence["/"]; ok {
+		var zero A
+		if p&goskema.PresenceWasNull != 0 {
+			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "cannot encode null as " + c.hint}}
+		}
+		if p&goskema.PresenceSeen == 0 {
+			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeRequired, Message: "missing value (preserving)"}}
+		}
+	}
+	return c.Encode(ctx, db.Value)
+}
+
+func (c *funcCodec[A, B]) issues(err error) error {
+	if iss, ok := goskema.AsIssues(err); ok {
+		return iss
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidFormat, Message: "invalid " + c.hint, Hint: err.Error(), Cause: err}}
+}
+
+// ---- endpoint schemas ----
+
+// valueSchema accepts values that already are T (domain side of a codec) and exports js.
+type valueSchema[T any] struct{ js js.Schema }
+
+func (s valueSchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	if t, ok := v.(T); ok {
+		return t, nil
+	}
+	var zero T
+	return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "unexpected value type"}}
+}
+func (s valueSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	t, err := s.Parse(ctx, v)
+	return goskema.Decoded[T]{Value: t, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+func (s valueSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	_, err := s.Parse(ctx, v)
+	return err
+}
+func (s valueSchema[T]) RuleCheck(ctx context.Context, v any) error   { return nil }
+func (s valueSchema[T]) Validate(ctx context.Context, v any) error    { return s.TypeCheck(ctx, v) }
+func (s valueSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
+func (s valueSchema[T]) JSONSchema() (*js.Schema, error) {
+	out := s.js
+	return &out, nil
+}
+
+// wireString is the wire schema of string-based codecs.
+type wireString struct{ js js.Schema }
+
+func (s wireString) Parse(ctx context.Context, v any) (string, error) {
+	if str, ok := v.(string); ok {
+		return str, nil
+	}
+	return "", goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "expected string"}}
+}
+func (s wireString) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[string], error) {
+	str, err := s.Parse(ctx, v)
+	return goskema.Decoded[string]{Value: str, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+func (s wireString) TypeCheck(ctx context.Context, v any) error {
+	_, err := s.Parse(ctx, v)
+	return err
+}
+func (s wireString) RuleCheck(ctx context.Context, v any) error        { return nil }
+func (s wireString) Validate(ctx context.Context, v any) error         { return s.TypeCheck(ctx, v) }
+func (s wireString) ValidateValue(ctx context.Context, v string) error { return nil }
+func (s wireString) JSONSchema() (*js.Schema, error) {
+	out := s.js
+	return &out, nil
+}
+
+// wireInt64 is the wire schema of integer-based codecs. It accepts json.Number, integral
+// float64 (encoding/json) and Go integer types.
+type wireInt64 struct{}
+
+func (wireInt64) Parse(ctx context.Context, v any) (int64, error) {
+	switch t := v.(type) {
+	case int64:
+		return t, nil
+	case int:
+		return int64(t), nil
+	case int32:
+		return int64(t), nil
+	case json.Number:
+		if n, err := strconv.ParseInt(string(t), 10, 64); err == nil {
+			return n, nil
+		}
+	case float64:
+		if t == math.Trunc(t) && math.Abs(t) < 1<<63 {
+			return int64(t), nil
+		}
+	}
+	return 0, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "expected integer"}}
+}
+func (s wireInt64) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[int64], error) {
+	n, err := s.Parse(ctx, v)
+	return goskema.Decoded[int64]{Value: n, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+func (s wireInt64) TypeCheck(ctx context.Context, v any) error {
+	_, err := s.Parse(ctx, v)
+	return err
+}
+func (wireInt64) RuleCheck(ctx context.Context, v any) error       { return nil }
+func (s wireInt64) Validate(ctx context.Context, v any) error      { return s.TypeCheck(ctx, v) }
+func (wireInt64) ValidateValue(ctx context.Context, v int64) error { return nil }
+func (wireInt64) JSONSchema() (*js.Schema, error)                  { return &js.Schema{Type: "integer"}, nil }
+
+// stringCodec builds a string-based codec whose JSON Schema is {type: string} plus wire.
+func stringCodec[B any](wire js.Schema, hint string, decode func(string) (B, error), encode func(B) (string, error)) goskema.Codec[string, B] {
+	wire.Type = "string"
+	return newFuncCodec[string, B](wireString{js: wire}, wire, hint, decode, encode)
+}
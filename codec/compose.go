@@ -0,0 +1,321 @@
+package codec
+
+import (
+	"context"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// Pipe chains ab and bc into a Codec[A,C]: Decode runs ab then bc, Encode runs bc then ab.
+// In() is ab.In(); Out() validates with bc.Out() and exports ab.Out().JSONSchema(), so a
+// dsl.Codec field built from a pipe publishes the outer wire form.
+// Presence reported by either stage is merged, and EncodePreserving hands it to both stages.
+//
+//	// JSON string -> bytes -> Payload
+//	c := codec.Pipe(codec.Base64(), payloadCodec)
+func Pipe[A, B, C any](ab goskema.Codec[A, B], bc goskema.Codec[B, C]) goskema.Codec[A, C] {
+	return &pipeCodec[A, B, C]{ab: ab, bc: bc}
+}
+
+type pipeCodec[A, B, C any] struct {
+	ab goskema.Codec[A, B]
+	bc goskema.Codec[B, C]
+}
+
+func (p *pipeCodec[A, B, C]) In() goskema.Schema[A] { return p.ab.In() }
+func (p *pipeCodec[A, B, C]) Out() goskema.Schema[C] {
+	return composedSchema[C]{validate: p.bc.Out().ValidateValue, wire: p.ab.Out().JSONSchema}
+}
+
+func (p *pipeCodec[A, B, C]) Decode(ctx context.Context, a A) (C, error) {
+	b, err := p.ab.Decode(ctx, a)
+	if err != nil {
+		var zero C
+		return zero, err
+	}
+	return p.bc.Decode(ctx, b)
+}
+
+func (p *pipeCodec[A, B, C]) Encode(ctx context.Context, c C) (A, error) {
+	b, err := p.bc.Encode(ctx, c)
+	if err != nil {
+		var zero A
+		return zero, err
+	}
+	return p.ab.Encode(ctx, b)
+}
+
+func (p *pipeCodec[A, B, C]) DecodeWithMeta(ctx context.Context, a A) (goskema.Decoded[C], error) {
+	db, err := p.ab.DecodeWithMeta(ctx, a)
+	if err != nil {
+		return goskema.Decoded[C]{Presence: db.Presence}, err
+	}
+	dc, err := p.bc.DecodeWithMeta(ctx, db.Value)
+	return goskema.Decoded[C]{Value: dc.Value, Presence: mergePresence(db.Presence, dc.Presence)}, err
+}
+
+func (p *pipeCodec[A, B, C]) EncodePreserving(ctx context.Context, dc goskema.Decoded[C]) (A, error) {
+	b, err := p.bc.EncodePreserving(ctx, dc)
+	if err != nil {
+		var zero A
+		return zero, err
+	}
+	return p.ab.EncodePreserving(ctx, goskema.Decoded[B]{Value: b, Presence: dc.Presence})
+}
+
+// Map derives a Codec[A,C] from c and a bijection between B and C: decode maps B to C after
+// c.Decode, encode maps C back before c.Encode. Use Pipe with a full codec when the
+// conversion can fail. JSON Schema and presence are those of c.
+//
+//	celsius := codec.Map(codec.Identity(g.NumberJSON()), toCelsius, fromCelsius)
+func Map[A, B, C any](c goskema.Codec[A, B], decode func(B) C, encode func(C) B) goskema.Codec[A, C] {
+	return &mapCodec[A, B, C]{c: c, decode: decode, encode: encode}
+}
+
+type mapCodec[A, B, C any] struct {
+	c      goskema.Codec[A, B]
+	decode func(B) C
+	encode func(C) B
+}
+
+func (m *mapCodec[A, B, C]) In() goskema.Schema[A] { return m.c.In() }
+func (m *mapCodec[A, B, C]) Out() goskema.Schema[C] {
+	out := m.c.Out()
+	return composedSchema[C]{
+		validate: func(ctx context.Context, v C) error { return out.ValidateValue(ctx, m.encode(v)) },
+		wire:     out.JSONSchema,
+	}
+}
+
+func (m *mapCodec[A, B, C]) Decode(ctx context.Context, a A) (C, error) {
+	b, err := m.c.Decode(ctx, a)
+	if err != nil {
+		var zero C
+		return zero, err
+	}
+	return m.decode(b), nil
+}
+
+func (m *mapCodec[A, B, C]) Encode(ctx context.Context, v C) (A, error) {
+	return m.c.Encode(ctx, m.encode(v))
+}
+
+func (m *mapCodec[A, B, C]) DecodeWithMeta(ctx context.Context, a A) (goskema.Decoded[C], error) {
+	db, err := m.c.DecodeWithMeta(ctx, a)
+	if err != nil {
+		return goskema.Decoded[C]{Presence: db.Presence}, err
+	}
+	return goskema.Decoded[C]{Value: m.decode(db.Value), Presence: db.Presence}, nil
+}
+
+func (m *mapCodec[A, B, C]) EncodePreserving(ctx context.Context, dc goskema.Decoded[C]) (A, error) {
+	return m.c.EncodePreserving(ctx, goskema.Decoded[B]{Value: m.encode(dc.Value), Presence: dc.Presence})
+}
+
+// Optional lifts c to pointers for values that may be absent: a nil *A decodes to a nil *B
+// with no presence at "/" (missing), and a nil *B encodes to nil. JSON null is not accepted by
+// In(); use Nullable for that. JSON Schema is that of c (optionality belongs to the enclosing
+// object's required list).
+func Optional[A, B any](c goskema.Codec[A, B]) goskema.Codec[*A, *B] {
+	return &ptrCodec[A, B]{c: c}
+}
+
+// Nullable lifts c to pointers for values that may be JSON null: In() accepts null as a nil
+// *A, which decodes to a nil *B with PresenceWasNull, and a nil *B encodes to nil.
+// JSON Schema: anyOf [c, {type: null}].
+func Nullable[A, B any](c goskema.Codec[A, B]) goskema.Codec[*A, *B] {
+	return &ptrCodec[A, B]{c: c, nullable: true}
+}
+
+type ptrCodec[A, B any] struct {
+	c        goskema.Codec[A, B]
+	nullable bool
+}
+
+func (p *ptrCodec[A, B]) In() goskema.Schema[*A] {
+	return ptrSchema[A]{inner: p.c.In(), nullable: p.nullable}
+}
+
+func (p *ptrCodec[A, B]) Out() goskema.Schema[*B] {
+	return ptrSchema[B]{inner: p.c.Out(), nullable: p.nullable}
+}
+
+func (p *ptrCodec[A, B]) Decode(ctx context.Context, a *A) (*B, error) {
+	if a == nil {
+		return nil, nil
+	}
+	b, err := p.c.Decode(ctx, *a)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (p *ptrCodec[A, B]) Encode(ctx context.Context, b *B) (*A, error) {
+	if b == nil {
+		return nil, nil
+	}
+	a, err := p.c.Encode(ctx, *b)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (p *ptrCodec[A, B]) DecodeWithMeta(ctx context.Context, a *A) (goskema.Decoded[*B], error) {
+	if a == nil {
+		pm := goskema.PresenceMap{}
+		if p.nullable {
+			pm["/"] = goskema.PresenceSeen | goskema.PresenceWasNull
+		}
+		return goskema.Decoded[*B]{Presence: pm}, nil
+	}
+	db, err := p.c.DecodeWithMeta(ctx, *a)
+	if err != nil {
+		return goskema.Decoded[*B]{Presence: db.Presence}, err
+	}
+	return goskema.Decoded[*B]{Value: &db.Value, Presence: db.Presence}, nil
+}
+
+// EncodePreserving emits nil for a nil value. A value set after decoding a null or missing
+// input is encoded as present: the stale null/missing state at "/" is not passed to c.
+func (p *ptrCodec[A, B]) EncodePreserving(ctx context.Context, db goskema.Decoded[*B]) (*A, error) {
+	if db.Value == nil {
+		return nil, nil
+	}
+	pm := db.Presence
+	if bits, ok := pm["/"]; ok && (bits&goskema.PresenceWasNull != 0 || bits&goskema.PresenceSeen == 0) {
+		pm = mergePresence(pm, nil)
+		pm["/"] = bits&^goskema.PresenceWasNull | goskema.PresenceSeen
+	}
+	a, err := p.c.EncodePreserving(ctx, goskema.Decoded[B]{Value: *db.Value, Presence: pm})
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// mergePresence returns a new map holding the union of the flags in a and b.
+func mergePresence(a, b goskema.PresenceMap) goskema.PresenceMap {
+	out := make(goskema.PresenceMap, len(a)+len(b))
+	for k, v := range a {
+		out[k] |= v
+	}
+	for k, v := range b {
+		out[k] |= v
+	}
+	return out
+}
+
+// ---- composed endpoint schemas ----
+
+// composedSchema is the Out schema of Pipe and Map: it accepts values that already are T,
+// validates them with validate and exports the JSON Schema of the outer wire form.
+type composedSchema[T any] struct {
+	validate func(context.Context, T) error
+	wire     func() (*js.Schema, error)
+}
+
+func (s composedSchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	t, ok := v.(T)
+	if !ok {
+		var zero T
+		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "unexpected value type"}}
+	}
+	if err := s.validate(ctx, t); err != nil {
+		var zero T
+		return zero, err
+	}
+	return t, nil
+}
+func (s composedSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	t, err := s.Parse(ctx, v)
+	return goskema.Decoded[T]{Value: t, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+func (s composedSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	if _, ok := v.(T); !ok {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "unexpected value type"}}
+	}
+	return nil
+}
+func (s composedSchema[T]) RuleCheck(ctx context.Context, v any) error {
+	if t, ok := v.(T); ok {
+		return s.validate(ctx, t)
+	}
+	return nil
+}
+func (s composedSchema[T]) Validate(ctx context.Context, v any) error {
+	_, err := s.Parse(ctx, v)
+	return err
+}
+func (s composedSchema[T]) ValidateValue(ctx context.Context, v T) error { return s.validate(ctx, v) }
+func (s composedSchema[T]) JSONSchema() (*js.Schema, error)              { return s.wire() }
+
+// ptrSchema lifts a Schema[T] to *T for Optional/Nullable. nil is accepted only when nullable
+// (JSON null); ValidateValue accepts a nil pointer either way.
+type ptrSchema[T any] struct {
+	inner    goskema.Schema[T]
+	nullable bool
+}
+
+func (s ptrSchema[T]) Parse(ctx context.Context, v any) (*T, error) {
+	switch t := v.(type) {
+	case nil:
+		if s.nullable {
+			return nil, nil
+		}
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "null is not allowed"}}
+	case *T:
+		if t == nil {
+			return nil, nil
+		}
+		if err := s.inner.ValidateValue(ctx, *t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	x, err := s.inner.Parse(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	return &x, nil
+}
+func (s ptrSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[*T], error) {
+	t, err := s.Parse(ctx, v)
+	bits := goskema.PresenceSeen
+	if v == nil {
+		bits |= goskema.PresenceWasNull
+	}
+	return goskema.Decoded[*T]{Value: t, Presence: goskema.PresenceMap{"/": bits}}, err
+}
+func (s ptrSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	if v == nil {
+		_, err := s.Parse(ctx, v)
+		return err
+	}
+	return s.inner.TypeCheck(ctx, v)
+}
+func (s ptrSchema[T]) RuleCheck(ctx context.Context, v any) error {
+	if v == nil {
+		return nil
+	}
+	return s.inner.RuleCheck(ctx, v)
+}
+func (s ptrSchema[T]) Validate(ctx context.Context, v any) error {
+	_, err := s.Parse(ctx, v)
+	return err
+}
+func (s ptrSchema[T]) ValidateValue(ctx context.Context, v *T) error {
+	if v == nil {
+		return nil
+	}
+	return s.inner.ValidateValue(ctx, *v)
+}
+func (s ptrSchema[T]) JSONSchema() (*js.Schema, error) {
+	inner, err := s.inner.JSONSchema()
+	if err != nil || !s.nullable {
+		return inner, err
+	}
+	return &js.Schema{AnyOf: []*js.Schema{inner, {Type: "null"}}}, nil
+}
@@ -0,0 +1,157 @@
+package codec_test
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/codec"
+	g "github.com/reoring/goskema/dsl"
+)
+
+// stampCodec decodes a base64 string holding an RFC3339 timestamp.
+func stampCodec() goskema.Codec[string, time.Time] {
+	text := codec.Map(codec.Base64(),
+		func(b []byte) string { return string(b) },
+		func(s string) []byte { return []byte(s) })
+	return codec.Pipe(text, codec.TimeRFC3339())
+}
+
+func TestPipe_DecodeEncode(t *testing.T) {
+	ctx := context.Background()
+	c := stampCodec()
+	wire := base64.StdEncoding.EncodeToString([]byte("2024-06-01T00:00:00Z"))
+	at, err := c.Decode(ctx, wire)
+	if err != nil || !at.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("decode: %v %v", at, err)
+	}
+	back, err := c.Encode(ctx, at)
+	if err != nil || back != wire {
+		t.Fatalf("encode: %q %v", back, err)
+	}
+
+	// failures of either stage surface as that stage's issues
+	if _, err := c.Decode(ctx, "%%%"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("bad base64: %v", err)
+	}
+	if _, err := c.Decode(ctx, base64.StdEncoding.EncodeToString([]byte("yesterday"))); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("bad time: %v", err)
+	}
+}
+
+func TestPipe_JSONSchemaIsOuterWire(t *testing.T) {
+	s, err := stampCodec().Out().JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Type != "string" || s.ContentEncoding != "base64" || s.Format != "" {
+		t.Fatalf("unexpected schema: %+v", s)
+	}
+}
+
+func TestMap_Trim(t *testing.T) {
+	ctx := context.Background()
+	trimmed := codec.Map(codec.Identity(g.String()), strings.TrimSpace, func(s string) string { return s })
+	c := codec.Pipe(trimmed, codec.TimeRFC3339())
+	at, err := c.Decode(ctx, "  2024-06-01T00:00:00Z\n")
+	if err != nil || at.Year() != 2024 {
+		t.Fatalf("decode: %v %v", at, err)
+	}
+}
+
+func TestNullable_Presence(t *testing.T) {
+	ctx := context.Background()
+	c := codec.Nullable(codec.TimeRFC3339())
+
+	if _, err := c.In().Parse(ctx, nil); err != nil {
+		t.Fatalf("null should be accepted: %v", err)
+	}
+	d, err := c.DecodeWithMeta(ctx, nil)
+	if err != nil || d.Value != nil || d.Presence["/"]&goskema.PresenceWasNull == 0 {
+		t.Fatalf("decode null: %+v %v", d, err)
+	}
+	out, err := c.EncodePreserving(ctx, d)
+	if err != nil || out != nil {
+		t.Fatalf("encode null: %v %v", out, err)
+	}
+
+	// a value set after decoding null encodes as present
+	at := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	d.Value = &at
+	out, err = c.EncodePreserving(ctx, d)
+	if err != nil || out == nil || *out != "2024-06-01T00:00:00Z" {
+		t.Fatalf("encode set value: %v %v", out, err)
+	}
+
+	s, _ := c.Out().JSONSchema()
+	if len(s.AnyOf) != 2 || s.AnyOf[0].Format != "date-time" || s.AnyOf[1].Type != "null" {
+		t.Fatalf("unexpected schema: %+v", s)
+	}
+}
+
+func TestOptional_RejectsNull(t *testing.T) {
+	ctx := context.Background()
+	c := codec.Optional(codec.UUID())
+	if _, err := c.In().Parse(ctx, nil); !hasCode(err, goskema.CodeInvalidType) {
+		t.Fatalf("null should be rejected: %v", err)
+	}
+	d, err := c.DecodeWithMeta(ctx, nil)
+	if err != nil || d.Value != nil {
+		t.Fatalf("decode missing: %+v %v", d, err)
+	}
+	if _, ok := d.Presence["/"]; ok {
+		t.Fatalf("missing value should have no presence: %v", d.Presence)
+	}
+	s, _ := c.Out().JSONSchema()
+	if s.Format != "uuid" {
+		t.Fatalf("unexpected schema: %+v", s)
+	}
+}
+
+func TestComposed_AsObjectField(t *testing.T) {
+	ctx := context.Background()
+	obj, err := g.Object().
+		Field("stamp", g.SchemaOf[time.Time](g.Codec[string, time.Time](stampCodec()))).
+		Field("deletedAt", g.SchemaOf[*time.Time](g.Codec[*string, *time.Time](codec.Nullable(codec.TimeRFC3339())))).
+		Require("stamp", "deletedAt").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wire := base64.StdEncoding.EncodeToString([]byte("2024-06-01T00:00:00Z"))
+	d, err := goskema.ParseFromWithMeta(ctx, obj, goskema.JSONBytes([]byte(`{"stamp":"`+wire+`","deletedAt":null}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if d.Value["stamp"].(time.Time).Year() != 2024 {
+		t.Fatalf("stamp: %#v", d.Value["stamp"])
+	}
+	if p, _ := d.Value["deletedAt"].(*time.Time); p != nil {
+		t.Fatalf("deletedAt should be nil: %v", p)
+	}
+	if d.Presence["/deletedAt"]&goskema.PresenceWasNull == 0 {
+		t.Fatalf("deletedAt presence: %v", d.Presence)
+	}
+
+	v, err := goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"stamp":"`+wire+`","deletedAt":"2024-07-01T00:00:00Z"}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if p := v["deletedAt"].(*time.Time); p == nil || p.Month() != time.July {
+		t.Fatalf("deletedAt: %v", p)
+	}
+
+	sch, err := obj.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := sch.Properties["stamp"]; p == nil || p.ContentEncoding != "base64" {
+		t.Fatalf("stamp schema: %+v", p)
+	}
+	if p := sch.Properties["deletedAt"]; p == nil || len(p.AnyOf) != 2 {
+		t.Fatalf("deletedAt schema: %+v", p)
+	}
+}
@@ -0,0 +1,107 @@
+package codec
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// durationPattern matches the strings accepted by time.ParseDuration.
+const durationPattern = `^[-+]?(0|([0-9]*(\.[0-9]*)?(ns|us|µs|μs|ms|s|m|h))+)$`
+
+// Duration returns a Codec that converts between Go duration strings ("1h30m", "250ms") and
+// time.Duration. Encode emits time.Duration.String().
+// JSON Schema: {type: string, pattern: ...}.
+func Duration() goskema.Codec[string, time.Duration] {
+	return stringCodec(js.Schema{Pattern: durationPattern}, "duration", time.ParseDuration,
+		func(d time.Duration) (string, error) { return d.String(), nil })
+}
+
+// DurationISO8601 returns a Codec that converts between ISO-8601 durations ("PT1H30M", "P2D",
+// "P1W") and time.Duration. Days are 24h and weeks 7 days; years and months have no fixed
+// length and are rejected, as are negative durations. Encode emits the canonical
+// "P[nD][T[nH][nM][n[.f]S]]" form ("PT0S" for zero).
+// JSON Schema: {type: string, format: duration}.
+func DurationISO8601() goskema.Codec[string, time.Duration] {
+	return stringCodec(js.Schema{Format: "duration"}, "ISO-8601 duration", parseISODuration, formatISODuration)
+}
+
+var isoDurationRe = regexp.MustCompile(`^P(?:(\d+)W|(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d{1,9})?)S)?)?)$`)
+
+func parseISODuration(s string) (time.Duration, error) {
+	m := isoDurationRe.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		if date, _, _ := strings.Cut(s, "T"); strings.ContainsAny(date, "YM") {
+			return 0, errors.New("years and months have no fixed length")
+		}
+		return 0, errors.New("expected PnW or PnDTnHnMnS")
+	}
+	var total time.Duration
+	add := func(n int64, unit time.Duration) bool {
+		if n > int64((math.MaxInt64-total)/unit) {
+			return false
+		}
+		total += time.Duration(n) * unit
+		return true
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	for i, unit := range units {
+		v := m[i+1]
+		if v == "" {
+			continue
+		}
+		whole, frac, _ := strings.Cut(v, ".")
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || !add(n, unit) {
+			return 0, errors.New("duration out of range")
+		}
+		if frac != "" {
+			ns, _ := strconv.ParseInt((frac + "000000000")[:9], 10, 64)
+			if !add(ns, time.Nanosecond) {
+				return 0, errors.New("duration out of range")
+			}
+		}
+	}
+	return total, nil
+}
+
+func formatISODuration(d time.Duration) (string, error) {
+	if d < 0 {
+		return "", errors.New("negative durations cannot be represented")
+	}
+	if d == 0 {
+		return "PT0S", nil
+	}
+	var b strings.Builder
+	b.WriteByte('P')
+	if days := d / (24 * time.Hour); days > 0 {
+		b.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+		d -= days * 24 * time.Hour
+	}
+	if d == 0 {
+		return b.String(), nil
+	}
+	b.WriteByte('T')
+	if h := d / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		d -= m * time.Minute
+	}
+	if d > 0 {
+		sec := strconv.FormatInt(int64(d/time.Second), 10)
+		if frac := d % time.Second; frac > 0 {
+			sec += "." + strings.TrimRight(strconv.FormatInt(int64(frac)+int64(time.Second), 10)[1:], "0")
+		}
+		b.WriteString(sec + "S")
+	}
+	return b.String(), nil
+}
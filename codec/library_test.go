@@ -0,0 +1,246 @@
+package codec_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/codec"
+	g "github.com/reoring/goskema/dsl"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+func TestDuration_Roundtrip(t *testing.T) {
+	ctx := context.Background()
+	c := codec.Duration()
+	d, err := c.Decode(ctx, "1h30m")
+	if err != nil || d != 90*time.Minute {
+		t.Fatalf("decode: %v %v", d, err)
+	}
+	if s, _ := c.Encode(ctx, d); s != "1h30m0s" {
+		t.Fatalf("encode: %q", s)
+	}
+	if _, err := c.Decode(ctx, "90 minutes"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("want invalid_format, got %v", err)
+	}
+}
+
+func TestDurationISO8601(t *testing.T) {
+	ctx := context.Background()
+	c := codec.DurationISO8601()
+	cases := []struct {
+		in   string
+		want time.Duration
+		out  string
+	}{
+		{"PT1H30M", 90 * time.Minute, "PT1H30M"},
+		{"P2D", 48 * time.Hour, "P2D"},
+		{"P1W", 7 * 24 * time.Hour, "P7D"},
+		{"P1DT0.25S", 24*time.Hour + 250*time.Millisecond, "P1DT0.25S"},
+		{"PT0S", 0, "PT0S"},
+	}
+	for _, tc := range cases {
+		d, err := c.Decode(ctx, tc.in)
+		if err != nil || d != tc.want {
+			t.Fatalf("%s: decode %v %v", tc.in, d, err)
+		}
+		if s, err := c.Encode(ctx, d); err != nil || s != tc.out {
+			t.Fatalf("%s: encode %q %v", tc.in, s, err)
+		}
+	}
+	for _, bad := range []string{"P1M", "P1Y", "PT", "P", "-PT1H", "1H"} {
+		if _, err := c.Decode(ctx, bad); !hasCode(err, goskema.CodeInvalidFormat) {
+			t.Fatalf("%s: want invalid_format, got %v", bad, err)
+		}
+	}
+	if _, err := c.Encode(ctx, -time.Second); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("negative encode: %v", err)
+	}
+}
+
+func TestUnixTime(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	if got, err := codec.UnixSeconds().Decode(ctx, at.Unix()); err != nil || !got.Equal(at) || got.Location() != time.UTC {
+		t.Fatalf("seconds: %v %v", got, err)
+	}
+	if n, _ := codec.UnixMillis().Encode(ctx, at.Add(1500*time.Millisecond)); n != at.UnixMilli()+1500 {
+		t.Fatalf("millis encode: %d", n)
+	}
+	// wire side accepts JSON numbers
+	if _, err := codec.UnixSeconds().In().Parse(ctx, json.Number("1717200000")); err != nil {
+		t.Fatalf("json.Number: %v", err)
+	}
+	if _, err := codec.UnixSeconds().In().Parse(ctx, 1.5); !hasCode(err, goskema.CodeInvalidType) {
+		t.Fatalf("fractional: %v", err)
+	}
+}
+
+func TestBase64(t *testing.T) {
+	ctx := context.Background()
+	raw := []byte{0xfb, 0xff, 0x00}
+	if s, _ := codec.Base64().Encode(ctx, raw); s != "+/8A" {
+		t.Fatalf("std encode: %q", s)
+	}
+	if s, _ := codec.Base64URL().Encode(ctx, []byte("a")); s != "YQ" {
+		t.Fatalf("url encode: %q", s)
+	}
+	for _, in := range []string{"YQ", "YQ=="} {
+		if b, err := codec.Base64URL().Decode(ctx, in); err != nil || string(b) != "a" {
+			t.Fatalf("url decode %q: %q %v", in, b, err)
+		}
+	}
+	if _, err := codec.Base64().Decode(ctx, "not base64!"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("want invalid_format, got %v", err)
+	}
+}
+
+func TestBigNumbers(t *testing.T) {
+	ctx := context.Background()
+	n, err := codec.BigInt().Decode(ctx, "-123456789012345678901234567890")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := codec.BigInt().Encode(ctx, n); s != "-123456789012345678901234567890" {
+		t.Fatalf("bigint roundtrip: %q", s)
+	}
+	for _, bad := range []string{"1e3", "+1", "0x10", ""} {
+		if _, err := codec.BigInt().Decode(ctx, bad); !hasCode(err, goskema.CodeInvalidFormat) {
+			t.Fatalf("bigint %q: %v", bad, err)
+		}
+	}
+
+	r, err := codec.BigRat().Decode(ctx, "12.50")
+	if err != nil || r.Cmp(big.NewRat(25, 2)) != 0 {
+		t.Fatalf("bigrat decode: %v %v", r, err)
+	}
+	if s, _ := codec.BigRat().Encode(ctx, big.NewRat(3, 8)); s != "0.375" {
+		t.Fatalf("bigrat encode: %q", s)
+	}
+	if _, err := codec.BigRat().Encode(ctx, big.NewRat(1, 3)); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("1/3 should not encode: %v", err)
+	}
+	if _, err := codec.BigRat().Decode(ctx, "1/3"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("fraction should not decode: %v", err)
+	}
+}
+
+func TestURLAndNetip(t *testing.T) {
+	ctx := context.Background()
+	u, err := codec.URL().Decode(ctx, "https://example.com/a?b=1")
+	if err != nil || u.Host != "example.com" {
+		t.Fatalf("url: %v %v", u, err)
+	}
+	if _, err := codec.URL().Decode(ctx, "/relative"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("relative url: %v", err)
+	}
+	if s, _ := codec.NetipAddr().Encode(ctx, netip.MustParseAddr("2001:DB8:0:0::1")); s != "2001:db8::1" {
+		t.Fatalf("addr encode: %q", s)
+	}
+	p, err := codec.NetipPrefix().Decode(ctx, "10.0.0.0/8")
+	if err != nil || p.Bits() != 8 {
+		t.Fatalf("prefix: %v %v", p, err)
+	}
+	if _, err := codec.NetipPrefix().Decode(ctx, "10.0.0.1"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("prefix without bits: %v", err)
+	}
+}
+
+func TestUUID(t *testing.T) {
+	ctx := context.Background()
+	u, err := codec.UUID().Decode(ctx, "123E4567-E89B-12D3-A456-426614174000")
+	if err != nil || u[0] != 0x12 || u[15] != 0x00 {
+		t.Fatalf("decode: %x %v", u, err)
+	}
+	if s, _ := codec.UUID().Encode(ctx, u); s != "123e4567-e89b-12d3-a456-426614174000" {
+		t.Fatalf("encode: %q", s)
+	}
+	if _, err := codec.UUID().Decode(ctx, "123e4567e89b12d3a456426614174000"); !hasCode(err, goskema.CodeInvalidFormat) {
+		t.Fatalf("want invalid_format, got %v", err)
+	}
+}
+
+type jsonSchemaer interface {
+	JSONSchema() (*js.Schema, error)
+}
+
+func TestLibrary_JSONSchema(t *testing.T) {
+	cases := []struct {
+		name    string
+		in, out jsonSchemaer
+		typ     string
+		format  string
+		enc     string
+		pattern bool
+	}{
+		{"Duration", codec.Duration().In(), codec.Duration().Out(), "string", "", "", true},
+		{"DurationISO8601", codec.DurationISO8601().In(), codec.DurationISO8601().Out(), "string", "duration", "", false},
+		{"UnixSeconds", codec.UnixSeconds().In(), codec.UnixSeconds().Out(), "integer", "", "", false},
+		{"UnixMillis", codec.UnixMillis().In(), codec.UnixMillis().Out(), "integer", "", "", false},
+		{"Base64", codec.Base64().In(), codec.Base64().Out(), "string", "", "base64", false},
+		{"Base64URL", codec.Base64URL().In(), codec.Base64URL().Out(), "string", "", "base64url", false},
+		{"BigInt", codec.BigInt().In(), codec.BigInt().Out(), "string", "", "", true},
+		{"BigRat", codec.BigRat().In(), codec.BigRat().Out(), "string", "", "", true},
+		{"URL", codec.URL().In(), codec.URL().Out(), "string", "uri", "", false},
+		{"NetipAddr", codec.NetipAddr().In(), codec.NetipAddr().Out(), "string", "ip", "", false},
+		{"NetipPrefix", codec.NetipPrefix().In(), codec.NetipPrefix().Out(), "string", "cidr", "", false},
+		{"UUID", codec.UUID().In(), codec.UUID().Out(), "string", "uuid", "", false},
+		{"TimeRFC3339", codec.TimeRFC3339().In(), codec.TimeRFC3339().Out(), "string", "date-time", "", false},
+	}
+	for _, tc := range cases {
+		for side, s := range map[string]jsonSchemaer{"in": tc.in, "out": tc.out} {
+			sch, err := s.JSONSchema()
+			if err != nil {
+				t.Fatalf("%s %s: %v", tc.name, side, err)
+			}
+			if sch.Type != tc.typ || sch.Format != tc.format || sch.ContentEncoding != tc.enc || (sch.Pattern != "") != tc.pattern {
+				t.Fatalf("%s %s: unexpected schema %+v", tc.name, side, sch)
+			}
+		}
+	}
+}
+
+func TestLibrary_FieldInObject(t *testing.T) {
+	ctx := context.Background()
+	obj, err := g.Object().
+		Field("id", g.SchemaOf[[16]byte](g.Codec[string, [16]byte](codec.UUID()))).
+		Field("ttl", g.SchemaOf[time.Duration](g.Codec[string, time.Duration](codec.DurationISO8601()))).
+		Field("at", g.SchemaOf[time.Time](g.Codec[int64, time.Time](codec.UnixSeconds()))).
+		Require("id", "ttl", "at").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"id":"123e4567-e89b-12d3-a456-426614174000","ttl":"PT5M","at":1717200000}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if v["ttl"].(time.Duration) != 5*time.Minute || v["at"].(time.Time).Unix() != 1717200000 {
+		t.Fatalf("unexpected value: %#v", v)
+	}
+	_, err = goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"id":"nope","ttl":"P1M","at":1}`)))
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 2 || iss[0].Path != "/id" || iss[1].Path != "/ttl" {
+		t.Fatalf("want issues at /id and /ttl, got %v", err)
+	}
+
+	sch, err := obj.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := sch.Properties["id"]; p == nil || p.Format != "uuid" {
+		t.Fatalf("id property: %+v", p)
+	}
+	if p := sch.Properties["at"]; p == nil || p.Type != "integer" {
+		t.Fatalf("at property: %+v", p)
+	}
+}
+
+func hasCode(err error, code string) bool {
+	iss, ok := goskema.AsIssues(err)
+	return ok && len(iss) > 0 && iss[0].Code == code
+}
@@ -0,0 +1,57 @@
+package codec
+
+import (
+	"errors"
+	"net/netip"
+	"net/url"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// URL returns a Codec that converts between absolute URI strings and *url.URL.
+// JSON Schema: {type: string, format: uri}.
+func URL() goskema.Codec[string, *url.URL] {
+	return stringCodec(js.Schema{Format: "uri"}, "URL",
+		func(s string) (*url.URL, error) {
+			u, err := url.Parse(s)
+			if err != nil {
+				return nil, err
+			}
+			if !u.IsAbs() {
+				return nil, errors.New("URL must be absolute")
+			}
+			return u, nil
+		},
+		func(u *url.URL) (string, error) {
+			if u == nil {
+				return "", errors.New("nil *url.URL")
+			}
+			return u.String(), nil
+		})
+}
+
+// NetipAddr returns a Codec that converts between IPv4/IPv6 address strings and netip.Addr.
+// Encode emits the canonical form (IPv6 compressed, lower case).
+// JSON Schema: {type: string, format: ip}.
+func NetipAddr() goskema.Codec[string, netip.Addr] {
+	return stringCodec(js.Schema{Format: "ip"}, "IP address", netip.ParseAddr,
+		func(a netip.Addr) (string, error) {
+			if !a.IsValid() {
+				return "", errors.New("zero netip.Addr")
+			}
+			return a.String(), nil
+		})
+}
+
+// NetipPrefix returns a Codec that converts between CIDR strings ("10.0.0.0/8") and
+// netip.Prefix. JSON Schema: {type: string, format: cidr}.
+func NetipPrefix() goskema.Codec[string, netip.Prefix] {
+	return stringCodec(js.Schema{Format: "cidr"}, "CIDR prefix", netip.ParsePrefix,
+		func(p netip.Prefix) (string, error) {
+			if !p.IsValid() {
+				return "", errors.New("zero netip.Prefix")
+			}
+			return p.String(), nil
+		})
+}
@@ -0,0 +1,26 @@
+package codec
+
+import (
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// UnixSeconds returns a Codec that converts between Unix time in seconds and time.Time.
+// Decode yields UTC; Encode truncates sub-second precision.
+// JSON Schema: {type: integer}.
+func UnixSeconds() goskema.Codec[int64, time.Time] {
+	return newFuncCodec[int64, time.Time](wireInt64{}, js.Schema{Type: "integer"}, "unix time",
+		func(n int64) (time.Time, error) { return time.Unix(n, 0).UTC(), nil },
+		func(t time.Time) (int64, error) { return t.Unix(), nil })
+}
+
+// UnixMillis returns a Codec that converts between Unix time in milliseconds and time.Time.
+// Decode yields UTC; Encode truncates sub-millisecond precision.
+// JSON Schema: {type: integer}.
+func UnixMillis() goskema.Codec[int64, time.Time] {
+	return newFuncCodec[int64, time.Time](wireInt64{}, js.Schema{Type: "integer"}, "unix time (ms)",
+		func(n int64) (time.Time, error) { return time.UnixMilli(n).UTC(), nil },
+		func(t time.Time) (int64, error) { return t.UnixMilli(), nil })
+}
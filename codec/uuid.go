@@ -0,0 +1,46 @@
+package codec
+
+import (
+	"encoding/hex"
+	"errors"
+
+	goskema "github.com/reoring/goskema"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// UUID returns a Codec that converts between UUID strings (8-4-4-4-12 hex, any case) and their
+// 16 bytes. Encode emits lower case.
+// JSON Schema: {type: string, format: uuid}.
+func UUID() goskema.Codec[string, [16]byte] {
+	return stringCodec(js.Schema{Format: "uuid"}, "UUID", parseUUID,
+		func(u [16]byte) (string, error) { return formatUUID(u), nil })
+}
+
+func parseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, errors.New("expected 8-4-4-4-12 hex digits")
+	}
+	j := 0
+	for _, g := range [...][2]int{{0, 8}, {9, 13}, {14, 18}, {19, 23}, {24, 36}} {
+		if _, err := hex.Decode(u[j:], []byte(s[g[0]:g[1]])); err != nil {
+			return u, errors.New("expected 8-4-4-4-12 hex digits")
+		}
+		j += (g[1] - g[0]) / 2
+	}
+	return u, nil
+}
+
+func formatUUID(u [16]byte) string {
+	var b [36]byte
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+	return string(b[:])
+}
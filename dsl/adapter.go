@@ -2,6 +2,7 @@ package dsl
 
 import (
 	"context"
+	"reflect"
 
 	goskema "github.com/reoring/goskema"
 	js "github.com/reoring/goskema/jsonschema"
@@ -15,8 +16,75 @@ type AnyAdapter struct {
 	validateValue   func(context.Context, any) error
 	parseFromSource func(context.Context, goskema.Source, goskema.ParseOpt) (any, error)
 	applyDefault    func(context.Context) (any, error)
-	jsonSchema      func() (*js.Schema, error)
+	jsonSchema      func(*jsonExport) (*js.Schema, error)
 	orig            any
+	// out is the Go type produced by parse (T of the wrapped Schema[T]); nil when unknown.
+	out reflect.Type
+	// staged marks adapters wrapped by Preprocess/Transform (see parseTracked).
+	staged bool
+	// derivedDefault marks an applyDefault that reads sibling fields (DefaultFrom); objects run
+	// it after the other fields have been parsed.
+	derivedDefault bool
+	// access holds the field access modes set on the object builder (ReadOnly/WriteOnly/Immutable).
+	access fieldAccess
+	// encode converts a parsed value back into its wire shape (goskema.WireEncoder); nil when
+	// parsed values already are wire values.
+	encode func(context.Context, any) (any, error)
+	// computedDefault marks an applyDefault set by DefaultFunc/DefaultFrom; canonical encoding
+	// does not materialize it.
+	computedDefault bool
+	// rewrap holds the wrappers added after construction (Preprocess/Transform/Coerce), in order,
+	// so that an adapter rebuilt over another schema gets them again (see rewrapOnto).
+	rewrap []func(AnyAdapter) AnyAdapter
+	// nullable marks adapters wrapped by Nullable.
+	nullable bool
+	// buildErr is a configuration error of a wrapper (e.g. Transform with a mismatched type),
+	// reported when the enclosing schema is built.
+	buildErr error
+}
+
+// withRewrap records w so that rewrapOnto applies it again.
+func (ad AnyAdapter) withRewrap(w func(AnyAdapter) AnyAdapter) AnyAdapter {
+	ad.rewrap = append(ad.rewrap[:len(ad.rewrap):len(ad.rewrap)], w)
+	return ad
+}
+
+// rewrapOnto applies the wrappers of ad to base, e.g. a partial copy of ad's object schema.
+func (ad AnyAdapter) rewrapOnto(base AnyAdapter) AnyAdapter {
+	for _, w := range ad.rewrap {
+		base = w(base)
+	}
+	return base
+}
+
+// buildChecker is implemented by schemas whose configuration is verified when the enclosing
+// object is built (e.g. format names resolved against a registry).
+type buildChecker interface{ checkBuild() error }
+
+// checkAdapterBuild reports the adapter's wrapper error, then runs buildChecker on its original
+// schema, if any.
+func checkAdapterBuild(ad AnyAdapter) error {
+	if ad.buildErr != nil {
+		return ad.buildErr
+	}
+	if bc, ok := ad.orig.(buildChecker); ok {
+		return bc.checkBuild()
+	}
+	return nil
+}
+
+// jsonExport is the state of one JSON Schema export, passed down to nested schemas: the Lazy
+// schemas being expanded, so that recursive references are exported as bare `$ref`s.
+type jsonExport struct{ visiting map[any]bool }
+
+// exportJSONSchema exports s as part of ex; schemas from outside this package start their own export.
+func exportJSONSchema(s interface{ JSONSchema() (*js.Schema, error) }, ex *jsonExport) (*js.Schema, error) {
+	if e, ok := s.(interface {
+		exportJSONSchema(*jsonExport) (*js.Schema, error)
+	}); ok {
+		return e.exportJSONSchema(ex)
+	}
+	return s.JSONSchema()
 }
 
 // anyAdapterFromSchema wraps a strongly typed Schema[T] as AnyAdapter for Field builders.
@@ -30,8 +98,19 @@ func anyAdapterFromSchema[T any](s goskema.Schema[T]) AnyAdapter {
 			}
 			return s.ValidateValue(ctx, tv)
 		},
-		jsonSchema: s.JSONSchema,
+		jsonSchema: func(ex *jsonExport) (*js.Schema, error) { return exportJSONSchema(s, ex) },
 		orig:       s,
+		out:        reflect.TypeOf((*T)(nil)).Elem(),
+	}
+
+	if we, ok := any(s).(goskema.WireEncoder[T]); ok {
+		ad.encode = func(ctx context.Context, v any) (any, error) {
+			tv, ok := v.(T)
+			if !ok {
+				return v, nil
+			}
+			return we.EncodeWire(ctx, tv)
+		}
 	}
 
 	type parseFromSourceLike[T any] interface {
@@ -63,6 +142,7 @@ func Nullable(ad AnyAdapter) AnyAdapter {
 	prevValidate := ad.validateValue
 	prevJSON := ad.jsonSchema
 	out := ad
+	out.nullable = true
 	out.parse = func(ctx context.Context, v any) (any, error) {
 		if v == nil {
 			return nil, nil
@@ -85,11 +165,11 @@ func Nullable(ad AnyAdapter) AnyAdapter {
 		}
 		return prevValidate(ctx, v)
 	}
-	out.jsonSchema = func() (*js.Schema, error) {
+	out.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
 		if prevJSON == nil {
 			return &js.Schema{}, nil
 		}
-		return prevJSON()
+		return prevJSON(ex)
 	}
 	return out
 }
@@ -18,6 +18,12 @@ type ArrayBuilder[E any] interface {
 	// predicate over raw element values before full element parsing. This allows early failure
 	// when max is exceeded and avoids buffering entire arrays.
 	WithStreamContains(min, max int, pred func(any) bool) ArrayBuilder[E]
+	// Unique rejects duplicate elements, reporting the later index with Params["firstIndex"].
+	// Use UniqueBy to compare by a derived key.
+	Unique() ArrayBuilder[E]
+	// Contains counts elements accepted by schema and requires the count to lie within
+	// [min, max] (-1 leaves a bound open). Exported as contains/minContains/maxContains.
+	Contains(schema goskema.Schema[E], min, max int) ArrayBuilder[E]
 }
 
 // Array returns an array schema with the given element schema.
@@ -33,6 +39,12 @@ type ArraySchema[E any] struct {
 	containsMin  int
 	containsMax  int
 	containsPred func(any) bool
+	// typed contains (Contains), bounded independently of the streaming predicate
+	containsSchema   goskema.Schema[E]
+	typedContainsMin int
+	typedContainsMax int
+	// uniqueness key (Unique/UniqueBy); nil disables the check
+	uniqueKey func(E) any
 }
 
 // ArrayOf adapts Array[E] to AnyAdapter for use in typed object builders.
@@ -41,6 +53,14 @@ func ArrayOf[E any](elem goskema.Schema[E]) AnyAdapter {
 	return anyAdapterFromSchema[[]E](Array[E](elem))
 }
 
+// checkBuild delegates build-time checks to the element schema.
+func (a *ArraySchema[E]) checkBuild() error {
+	if bc, ok := any(a.elem).(buildChecker); ok {
+		return bc.checkBuild()
+	}
+	return nil
+}
+
 // Min sets the minimum length.
 func (a *ArraySchema[E]) Min(n int) ArrayBuilder[E] { a.minLen = n; return a }
 
@@ -79,7 +99,7 @@ func (a *ArraySchema[E]) Parse(ctx context.Context, v any) ([]E, error) {
 	case []any:
 		res := make([]E, 0, len(src))
 		for i := range src {
-			ev, err := a.elem.Parse(ctx, src[i])
+			ev, err := a.elem.Parse(scopeAtIndex(ctx, i), src[i])
 			if err != nil {
 				if iss, ok := goskema.AsIssues(err); ok {
 					base := "/" + strconv.Itoa(i)
@@ -121,8 +141,7 @@ func (a *ArraySchema[E]) Parse(ctx context.Context, v any) ([]E, error) {
 }
 
 func (a *ArraySchema[E]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[[]E], error) {
-	arr, err := a.Parse(ctx, v)
-	return goskema.Decoded[[]E]{Value: arr, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) ([]E, error) { return a.Parse(ctx, v) })
 }
 
 func (a *ArraySchema[E]) TypeCheck(ctx context.Context, v any) error {
@@ -145,6 +164,9 @@ func (a *ArraySchema[E]) RuleCheck(ctx context.Context, v any) error {
 		return nil
 	}
 	var iss goskema.Issues
+	if t, ok := v.([]E); ok {
+		iss = a.elementIssues(ctx, t)
+	}
 	if a.minLen >= 0 && n < a.minLen {
 		iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/", Code: goskema.CodeTooShort, Message: i18n.T(goskema.CodeTooShort, nil), Hint: "array is shorter than min"})
 	}
@@ -165,6 +187,18 @@ func (a *ArraySchema[E]) Validate(ctx context.Context, v any) error {
 }
 
 func (a *ArraySchema[E]) ValidateValue(ctx context.Context, v []E) error {
+	if err := a.validateElems(ctx, v); err != nil {
+		return err
+	}
+	if iss := a.elementIssues(ctx, v); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+
+// validateElems checks length bounds and each element; uniqueness and contains are left to
+// elementIssues (the streaming paths run them per element).
+func (a *ArraySchema[E]) validateElems(ctx context.Context, v []E) error {
 	if a.minLen >= 0 && len(v) < a.minLen {
 		return goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeTooShort, Message: i18n.T(goskema.CodeTooShort, nil), Hint: "array is shorter than min"}}
 	}
@@ -173,15 +207,17 @@ func (a *ArraySchema[E]) ValidateValue(ctx context.Context, v []E) error {
 	}
 	for i := range v {
 		if err := a.elem.ValidateValue(ctx, v[i]); err != nil {
-			return err
+			return rebaseIssuesUnder("/"+strconv.Itoa(i), issuesFromErr("/", err))
 		}
 	}
 	return nil
 }
 
-func (a *ArraySchema[E]) JSONSchema() (*js.Schema, error) {
+func (a *ArraySchema[E]) JSONSchema() (*js.Schema, error) { return a.exportJSONSchema(&jsonExport{}) }
+
+func (a *ArraySchema[E]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
 	// element schema
-	es, err := a.elem.JSONSchema()
+	es, err := exportJSONSchema(a.elem, ex)
 	if err != nil {
 		return nil, err
 	}
@@ -194,5 +230,24 @@ func (a *ArraySchema[E]) JSONSchema() (*js.Schema, error) {
 		n := a.maxLen
 		s.MaxItems = &n
 	}
+	s.UniqueItems = a.uniqueKey != nil
+	if a.containsSchema != nil {
+		cs, err := exportJSONSchema(a.containsSchema, ex)
+		if err != nil {
+			return nil, err
+		}
+		s.Contains = cs
+		// JSON Schema defaults minContains to 1; an open lower bound is minContains: 0.
+		if minC := max(a.typedContainsMin, 0); minC != 1 {
+			s.MinContains = &minC
+		}
+		if a.typedContainsMax >= 0 {
+			n := a.typedContainsMax
+			s.MaxContains = &n
+		}
+	}
 	return s, nil
 }
+
+// elemSchema exposes the element schema for nested struct projection in Bind.
+func (a *ArraySchema[E]) elemSchema() any { return a.elem }
@@ -47,6 +47,7 @@ func (a *ArraySchema[E]) ParseFromSource(ctx context.Context, src goskema.Source
 	// streaming contains counters
 	doContains := a.containsPred != nil && (a.containsMin >= 0 || a.containsMax >= 0)
 	matched := 0
+	checks := a.newElementChecks()
 	for {
 		t, err := enforced.NextToken()
 		if err != nil {
@@ -71,12 +72,22 @@ func (a *ArraySchema[E]) ParseFromSource(ctx context.Context, src goskema.Source
 					return nil, goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeTooLong, Message: i18n.T(goskema.CodeTooLong, nil), Hint: "contains max exceeded"}}
 				}
 			}
+			// Unique/Contains still see every element that the element schema accepts.
+			if checks.active() {
+				if ev, perr := a.elem.Parse(scopeAtIndex(ctx, idx), anyVal); perr == nil {
+					eiss, stop := checks.step(ctx, idx, ev)
+					if stop || (len(eiss) > 0 && goskema.IsFailFast(ctx)) {
+						return nil, goskema.AppendIssues(iss, eiss...)
+					}
+					iss = goskema.AppendIssues(iss, eiss...)
+				}
+			}
 			// We consumed the subtree via DecodeAnyFromSource; continue to next element without full parse.
 			idx++
 			continue
 		}
 		pre := str.NewPreloadedSource(enforced, t)
-		ev, perr := goskema.ParseFrom(ctx, a.elem, goskema.SourceFromEngine(pre, src.NumberMode()), opt)
+		ev, perr := goskema.ParseFrom(scopeAtIndex(ctx, idx), a.elem, goskema.SourceFromEngine(pre, src.NumberMode()), opt)
 		if perr != nil {
 			if i2, ok := goskema.AsIssues(perr); ok {
 				base := "/" + strconv.Itoa(idx)
@@ -103,6 +114,11 @@ func (a *ArraySchema[E]) ParseFromSource(ctx context.Context, src goskema.Source
 				iss = goskema.AppendIssues(iss, ie)
 			}
 		} else {
+			eiss, stop := checks.step(ctx, idx, ev)
+			if stop || (len(eiss) > 0 && goskema.IsFailFast(ctx)) {
+				return nil, goskema.AppendIssues(iss, eiss...)
+			}
+			iss = goskema.AppendIssues(iss, eiss...)
 			out = append(out, ev)
 		}
 		idx++
@@ -115,14 +131,19 @@ func (a *ArraySchema[E]) ParseFromSource(ctx context.Context, src goskema.Source
 		// matched <= max is already enforced during scan
 		// no further element parsing was done in contains-fast path, so out remains nil
 		// Return empty typed slice (no projection) as contains-only validation path.
+		iss = goskema.AppendIssues(iss, checks.finish()...)
+		if len(iss) > 0 {
+			return nil, iss
+		}
 		return []E{}, nil
 	}
 
+	iss = goskema.AppendIssues(iss, checks.finish()...)
 	if len(iss) > 0 {
 		return nil, iss
 	}
 
-	if err := a.ValidateValue(ctx, out); err != nil {
+	if err := a.validateElems(ctx, out); err != nil {
 		return nil, err
 	}
 
@@ -176,8 +197,11 @@ func (a *ArraySchema[E]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 
 	var out []E
 	pm := goskema.PresenceMap{"/": goskema.PresenceSeen}
+	outer := ctx
+	ctx = goskema.CollectPresence(ctx, pm)
 	var iss goskema.Issues
 	idx := 0
+	checks := a.newElementChecks()
 	for {
 		t, err := enforced.NextToken()
 		if err != nil {
@@ -192,7 +216,7 @@ func (a *ArraySchema[E]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 			pm[path] |= goskema.PresenceWasNull
 		}
 		pre := str.NewPreloadedSource(enforced, t)
-		dv, perr := goskema.ParseFromWithMeta(ctx, a.elem, goskema.SourceFromEngine(pre, src.NumberMode()), opt)
+		dv, perr := goskema.ParseFromWithMeta(scopeAtIndex(ctx, idx), a.elem, goskema.SourceFromEngine(pre, src.NumberMode()), opt)
 		if perr != nil {
 			if i2, ok := goskema.AsIssues(perr); ok {
 				base := path
@@ -217,16 +241,22 @@ func (a *ArraySchema[E]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 				iss = goskema.AppendIssues(iss, ie)
 			}
 		} else {
+			eiss, stop := checks.step(ctx, idx, dv.Value)
+			if stop || (len(eiss) > 0 && goskema.IsFailFast(ctx)) {
+				return goskema.Decoded[[]E]{Value: nil, Presence: pm}, goskema.AppendIssues(iss, eiss...)
+			}
+			iss = goskema.AppendIssues(iss, eiss...)
 			out = append(out, dv.Value)
 		}
 		idx++
 	}
 
+	iss = goskema.AppendIssues(iss, checks.finish()...)
 	if len(iss) > 0 {
 		return goskema.Decoded[[]E]{Value: nil, Presence: pm}, iss
 	}
 
-	if err := a.ValidateValue(ctx, out); err != nil {
+	if err := a.validateElems(ctx, out); err != nil {
 		return goskema.Decoded[[]E]{Value: nil, Presence: pm}, err
 	}
 
@@ -247,5 +277,6 @@ func (a *ArraySchema[E]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 	if err := goskema.ApplyRefine[[]E](ctx, nn, a); err != nil {
 		return goskema.Decoded[[]E]{}, err
 	}
+	reportPresence(outer, pm)
 	return goskema.Decoded[[]E]{Value: nn, Presence: pm}, nil
 }
@@ -0,0 +1,156 @@
+package dsl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+)
+
+// Unique rejects arrays with duplicate elements. Comparable values are compared with ==,
+// other values (maps, slices) by their canonical JSON encoding.
+func (a *ArraySchema[E]) Unique() ArrayBuilder[E] {
+	a.uniqueKey = func(e E) any { return uniqueKeyOf(e) }
+	return a
+}
+
+// Contains requires the number of elements accepted by schema (ValidateValue succeeds) to lie
+// within [min, max]; pass -1 to leave a bound open. When streaming, max is enforced as soon as
+// it is exceeded. The bounds are independent of WithStreamContains.
+func (a *ArraySchema[E]) Contains(schema goskema.Schema[E], min, max int) ArrayBuilder[E] {
+	a.containsSchema = schema
+	a.typedContainsMin = min
+	a.typedContainsMax = max
+	return a
+}
+
+// UniqueBy rejects arrays in which two elements share the same key(e).
+// Example: UniqueBy(Array[Item](item), func(it Item) string { return it.ID })
+func UniqueBy[E any, K comparable](ab ArrayBuilder[E], key func(E) K) ArrayBuilder[E] {
+	a, ok := ab.(*ArraySchema[E])
+	if !ok || key == nil {
+		return ab
+	}
+	a.uniqueKey = func(e E) any { return uniqueKeyOf(key(e)) }
+	return a
+}
+
+// UniqueIssues reports every element of v equal to an earlier one, using the same comparison as
+// Unique. Issues are placed at "/<index>" with Params["firstIndex"].
+func UniqueIssues[E any](v []E) goskema.Issues {
+	seen := uniqueSet{}
+	var iss goskema.Issues
+	for i := range v {
+		if j, dup := seen.add(uniqueKeyOf(v[i]), i); dup {
+			iss = goskema.AppendIssues(iss, duplicateIssue(i, j))
+		}
+	}
+	return iss
+}
+
+// uniqueDigest stands in for non-comparable values, keeping each set entry fixed-size.
+type uniqueDigest [sha256.Size]byte
+
+// uniqueKeyOf returns a map key for v: v itself when comparable, otherwise a digest of its JSON.
+func uniqueKeyOf(v any) any {
+	if v == nil || reflect.ValueOf(v).Comparable() {
+		return v
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		b = []byte(fmt.Sprintf("%#v", v))
+	}
+	return uniqueDigest(sha256.Sum256(b))
+}
+
+// uniqueSet remembers the first index of each element key.
+type uniqueSet map[any]int
+
+// add records key at index i, or returns the earlier index when key was already seen.
+func (u uniqueSet) add(key any, i int) (int, bool) {
+	if j, ok := u[key]; ok {
+		return j, true
+	}
+	u[key] = i
+	return 0, false
+}
+
+func duplicateIssue(i, j int) goskema.Issue {
+	return goskema.Issue{
+		Path:    "/" + strconv.Itoa(i),
+		Code:    goskema.CodeDuplicateItem,
+		Message: i18n.T(goskema.CodeDuplicateItem, nil),
+		Hint:    "first at /" + strconv.Itoa(j),
+		Params:  map[string]any{"firstIndex": j},
+	}
+}
+
+// elementChecks tracks uniqueness and typed contains across elements, so the streaming paths
+// can fail at the offending element instead of after the whole array was read.
+type elementChecks[E any] struct {
+	a       *ArraySchema[E]
+	seen    uniqueSet
+	matched int
+}
+
+func (a *ArraySchema[E]) newElementChecks() *elementChecks[E] {
+	c := &elementChecks[E]{a: a}
+	if a.uniqueKey != nil {
+		c.seen = uniqueSet{}
+	}
+	return c
+}
+
+// active reports whether any element check is configured.
+func (c *elementChecks[E]) active() bool { return c.seen != nil || c.a.containsSchema != nil }
+
+// step checks the element at index i. stop reports that the array can no longer pass
+// (contains max exceeded).
+func (c *elementChecks[E]) step(ctx context.Context, i int, e E) (iss goskema.Issues, stop bool) {
+	if c.seen != nil {
+		if j, dup := c.seen.add(c.a.uniqueKey(e), i); dup {
+			iss = goskema.AppendIssues(iss, duplicateIssue(i, j))
+		}
+	}
+	if c.a.containsSchema != nil && c.a.containsSchema.ValidateValue(ctx, e) == nil {
+		c.matched++
+		if c.a.typedContainsMax >= 0 && c.matched > c.a.typedContainsMax {
+			return goskema.AppendIssues(iss, c.a.containsMaxIssue(c.matched)), true
+		}
+	}
+	return iss, false
+}
+
+// finish reports a contains min violation once all elements were seen.
+func (c *elementChecks[E]) finish() goskema.Issues {
+	if c.a.containsSchema != nil && c.a.typedContainsMin >= 0 && c.matched < c.a.typedContainsMin {
+		return goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeTooShort, Message: i18n.T(goskema.CodeTooShort, nil), Hint: "contains min not met", Params: map[string]any{"min": c.a.typedContainsMin, "got": c.matched}}}
+	}
+	return nil
+}
+
+func (a *ArraySchema[E]) containsMaxIssue(got int) goskema.Issue {
+	return goskema.Issue{Path: "/", Code: goskema.CodeTooLong, Message: i18n.T(goskema.CodeTooLong, nil), Hint: "contains max exceeded", Params: map[string]any{"max": a.typedContainsMax, "got": got}}
+}
+
+// elementIssues runs the uniqueness and contains checks over a fully decoded array.
+func (a *ArraySchema[E]) elementIssues(ctx context.Context, v []E) goskema.Issues {
+	c := a.newElementChecks()
+	if !c.active() {
+		return nil
+	}
+	var iss goskema.Issues
+	for i := range v {
+		eiss, stop := c.step(ctx, i, v[i])
+		iss = goskema.AppendIssues(iss, eiss...)
+		if stop || (len(iss) > 0 && goskema.IsFailFast(ctx)) {
+			return iss
+		}
+	}
+	return goskema.AppendIssues(iss, c.finish()...)
+}
@@ -0,0 +1,135 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestArray_Unique_Parse(t *testing.T) {
+	arr := g.Array[string](g.String()).Unique()
+	ctx := context.Background()
+	if _, err := arr.Parse(ctx, []any{"a", "b"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := arr.Parse(ctx, []any{"a", "b", "a", "b"})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 2 {
+		t.Fatalf("expected two duplicate issues, got %v", err)
+	}
+	if iss[0].Path != "/2" || iss[0].Code != goskema.CodeDuplicateItem || iss[0].Params["firstIndex"] != 0 {
+		t.Fatalf("unexpected issue: %+v", iss[0])
+	}
+	if iss[1].Path != "/3" || iss[1].Params["firstIndex"] != 1 {
+		t.Fatalf("unexpected issue: %+v", iss[1])
+	}
+}
+
+func TestArray_Unique_NonComparableElements(t *testing.T) {
+	arr := g.Array[map[string]any](g.MapAny()).Unique()
+	_, err := arr.Parse(context.Background(), []any{
+		map[string]any{"a": 1.0, "b": "x"},
+		map[string]any{"b": "x", "a": 1.0},
+	})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Path != "/1" {
+		t.Fatalf("expected duplicate at /1, got %v", err)
+	}
+}
+
+func TestArray_UniqueBy_Streaming(t *testing.T) {
+	type item struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	elem := g.ObjectOf[item]().
+		Field("id", g.StringOf[string]()).Required().
+		Field("name", g.StringOf[string]()).
+		MustBind()
+	arr := g.UniqueBy(g.Array[item](elem), func(it item) string { return it.ID })
+
+	ctx := context.Background()
+	if _, err := goskema.ParseFrom(ctx, arr, goskema.JSONBytes([]byte(`[{"id":"a","name":"x"},{"id":"b","name":"x"}]`))); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := goskema.ParseFrom(ctx, arr, goskema.JSONBytes([]byte(`[{"id":"a"},{"id":"b"},{"id":"a","name":"y"}]`)))
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Path != "/2" || iss[0].Params["firstIndex"] != 0 {
+		t.Fatalf("expected duplicate at /2, got %v", err)
+	}
+	dm, err := goskema.ParseFromWithMeta(ctx, arr, goskema.JSONBytes([]byte(`[{"id":"a"},{"id":"a"}]`)))
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/1" {
+		t.Fatalf("expected duplicate at /1, got %v", err)
+	}
+	if dm.Presence["/1"]&goskema.PresenceSeen == 0 {
+		t.Fatalf("expected element presence, got %v", dm.Presence)
+	}
+}
+
+func TestArray_Contains_Typed(t *testing.T) {
+	admin := goskema.Schema[string](g.Enum[string]("admin"))
+	arr := g.Array[string](g.String()).Contains(admin, 1, 2)
+	ctx := context.Background()
+
+	if _, err := arr.Parse(ctx, []any{"user", "admin"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := arr.Parse(ctx, []any{"user"})
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Code != goskema.CodeTooShort || iss[0].Params["min"] != 1 {
+		t.Fatalf("expected contains min violation, got %v", err)
+	}
+	// streaming stops at the third match
+	_, err = goskema.ParseFrom(ctx, arr, goskema.JSONBytes([]byte(`["admin","admin","admin",1]`)))
+	if iss, ok := goskema.AsIssues(err); !ok || len(iss) != 1 || iss[0].Code != goskema.CodeTooLong || iss[0].Params["got"] != 3 {
+		t.Fatalf("expected contains max violation, got %v", err)
+	}
+	// the streaming result keeps the parsed elements
+	got, err := goskema.ParseFrom(ctx, arr, goskema.JSONBytes([]byte(`["admin","user"]`)))
+	if err != nil || len(got) != 2 {
+		t.Fatalf("unexpected result: %v %v", got, err)
+	}
+}
+
+func TestArray_Contains_WithStreamContains(t *testing.T) {
+	admin := goskema.Schema[string](g.Enum[string]("admin"))
+	isUser := func(v any) bool { return v == "user" }
+	// typed contains keeps its own bounds next to the streaming predicate
+	ab := g.Array[string](g.String()).Contains(admin, 1, -1).WithStreamContains(0, 1, isUser)
+	ctx := context.Background()
+
+	if _, err := goskema.ParseFrom(ctx, ab, goskema.JSONBytes([]byte(`["admin","user"]`))); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := goskema.ParseFrom(ctx, ab, goskema.JSONBytes([]byte(`["user"]`)))
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Code != goskema.CodeTooShort || iss[0].Params["min"] != 1 {
+		t.Fatalf("expected typed contains min violation on the streaming contains path, got %v", err)
+	}
+	_, err = goskema.ParseFrom(ctx, ab, goskema.JSONBytes([]byte(`["admin","user","user"]`)))
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Code != goskema.CodeTooLong {
+		t.Fatalf("expected streaming contains max violation, got %v", err)
+	}
+
+	uq := g.Array[string](g.String()).Unique().WithStreamContains(0, -1, isUser)
+	if _, err := goskema.ParseFrom(ctx, uq, goskema.JSONBytes([]byte(`["a","a"]`))); err == nil {
+		t.Fatalf("expected duplicate item on the streaming contains path")
+	}
+}
+
+func TestArray_UniqueContains_JSONSchema(t *testing.T) {
+	admin := goskema.Schema[string](g.Enum[string]("admin"))
+	sch, err := g.Array[string](g.String()).Unique().Contains(admin, -1, 3).JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(sch)
+	out := string(b)
+	for _, frag := range []string{`"uniqueItems":true`, `"contains":{"type":"string","enum":["admin"]}`, `"minContains":0`, `"maxContains":3`} {
+		if !strings.Contains(out, frag) {
+			t.Errorf("missing %s in %s", frag, out)
+		}
+	}
+}
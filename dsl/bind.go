@@ -33,10 +33,11 @@ func MustBind[T any](b *objectBuilder) goskema.Schema[T] {
 }
 
 // typedObjectSchema adapts an objectSchema to a typed struct T using key resolution.
+// Nested objects, slices/maps of objects and pointer fields are projected via a plan built at Bind time.
 type typedObjectSchema[T any] struct {
 	inner       *objectSchema
 	t           reflect.Type
-	fieldByKey  map[string]int // DSL key -> struct field index
+	plan        *structPlan
 	typedRules  []typedRule[T]
 	typedRulesE []typedRuleE[T]
 }
@@ -51,23 +52,10 @@ func newTypedObjectSchema[T any](os *objectSchema) (goskema.Schema[T], error) {
 	if rt.Kind() != reflect.Struct {
 		return zero, goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeParseError, Message: "Bind[T] requires struct T"}}
 	}
-	idxByName := make(map[string]int)
-	for i := 0; i < rt.NumField(); i++ {
-		sf := rt.Field(i)
-		if !sf.IsExported() {
-			continue
-		}
-		name := goskema.ResolveStructKey(sf)
-		if name == "-" || name == "" {
-			continue
-		}
-		idxByName[name] = i
-	}
-	fm := make(map[string]int)
-	for k := range os.fields {
-		if i, ok := idxByName[k]; ok {
-			fm[k] = i
-		}
+	// every bound field's Go type must accept its adapter's output type
+	plan, iss := planStruct(rt, os)
+	if len(iss) > 0 {
+		return zero, iss
 	}
 	// rehydrate typed rules for T
 	var trs []typedRule[T]
@@ -86,51 +74,31 @@ func newTypedObjectSchema[T any](os *objectSchema) (goskema.Schema[T], error) {
 			}
 		}
 	}
-	return &typedObjectSchema[T]{inner: os, t: rt, fieldByKey: fm, typedRules: trs, typedRulesE: trse}, nil
+	return &typedObjectSchema[T]{inner: os, t: rt, plan: plan, typedRules: trs, typedRulesE: trse}, nil
 }
 
 // Parse maps wire -> map via inner, then into struct fields by mapping.
 func (s *typedObjectSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 	var zero T
-	m, err := s.inner.Parse(ctx, v)
+	m, err := s.inner.Parse(s.requestCtx(ctx), v)
 	if err != nil {
 		return zero, err
 	}
-	rv := reflect.New(s.t).Elem()
-	for key, idx := range s.fieldByKey {
-		if val, ok := m[key]; ok {
-			fv := rv.Field(idx)
-			if !fv.CanSet() {
-				continue
-			}
-			// Gracefully handle nulls for nillable fields
-			if val == nil {
-				switch fv.Kind() {
-				case reflect.Interface, reflect.Pointer, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
-					fv.Set(reflect.Zero(fv.Type()))
-				default:
-					// leave zero value for non-nillable fields
-				}
-				continue
-			}
-			vv := reflect.ValueOf(val)
-			if vv.Type().AssignableTo(fv.Type()) {
-				fv.Set(vv)
-			} else if vv.Type().ConvertibleTo(fv.Type()) {
-				fv.Set(vv.Convert(fv.Type()))
-			} else {
-				return zero, goskema.Issues{goskema.Issue{Path: "/" + key, Code: goskema.CodeInvalidType, Message: "field type mismatch"}}
-			}
-		}
+	rv, iss := s.plan.build(m)
+	if len(iss) > 0 {
+		return zero, iss
 	}
 	out := rv.Interface().(T)
 	// Execute typed rules also on Parse path (without returning presence). We reconstruct
 	// a minimal PresenceMap from the wire map to enable presence-gated rules.
 	if (len(s.typedRules) > 0 || len(s.typedRulesE) > 0) && !goskema.IsSkipTypedRules(ctx) {
 		pm := goskema.PresenceMap{"/": goskema.PresenceSeen}
-		// minimally mark seen keys at top-level from the wire map
-		for k := range m {
+		// minimally mark seen (and null) keys at top-level from the wire map
+		for k, v := range m {
 			pm["/"+k] |= goskema.PresenceSeen
+			if v == nil {
+				pm["/"+k] |= goskema.PresenceWasNull
+			}
 		}
 		if len(s.typedRules) > 0 {
 			if iss := runTypedRules[T](ctx, out, pm, s.typedRules, goskema.PhaseDomain); len(iss) > 0 {
@@ -155,15 +123,17 @@ func (s *typedObjectSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 
 func (s *typedObjectSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
 	var zero goskema.Decoded[T]
-	dm, err := s.inner.ParseWithMeta(ctx, v)
+	dm, err := s.inner.ParseWithMeta(s.requestCtx(ctx), v)
 	if err != nil {
 		return zero, err
 	}
-	// At this point, ParseFromWithMeta has set the skip flag so that s.Parse won't execute typed rules.
-	out, err := s.Parse(ctx, dm.Value)
-	if err != nil {
-		return zero, err
+	// dm.Value is parsed already: build the struct from it instead of parsing it again, which
+	// would also record every defaulted field as seen.
+	rv, iss := s.plan.build(dm.Value)
+	if len(iss) > 0 {
+		return zero, iss
 	}
+	out := rv.Interface().(T)
 	// typed rules: domain phase, then context phase (stop on issues across phases)
 	if len(s.typedRules) > 0 || len(s.typedRulesE) > 0 {
 		if len(s.typedRules) > 0 {
@@ -184,11 +154,23 @@ func (s *typedObjectSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskem
 			}
 		}
 	}
-	// Note: Presence keys are in wire (map) shape using DSL keys. Bind maps DSL keys to struct fields,
-	// so we can carry PresenceMap as-is for preserving encode decisions at object level.
+	// Note: Presence keys are in wire (map) shape using DSL keys at every depth, which is the shape
+	// EncodePreservingToMap prunes after encoding the struct, so PresenceMap is carried as-is.
 	return goskema.Decoded[T]{Value: out, Presence: dm.Presence}, nil
 }
 
+func (s *typedObjectSchema[T]) requestScoped() {}
+
+// requestCtx converts a typed RequestInfo.Old (*T) into the wire map the inner object compares
+// immutable fields against.
+func (s *typedObjectSchema[T]) requestCtx(ctx context.Context) context.Context {
+	req, ok := goskema.RequestFrom[T](ctx)
+	if !ok || req.Old == nil {
+		return ctx
+	}
+	return goskema.WithRequestOld(ctx, s.plan.toMap(reflect.ValueOf(*req.Old)))
+}
+
 func (s *typedObjectSchema[T]) TypeCheck(ctx context.Context, v any) error {
 	return s.inner.TypeCheck(ctx, v)
 }
@@ -204,16 +186,11 @@ func (s *typedObjectSchema[T]) ValidateValue(ctx context.Context, v T) error {
 	if rv.Kind() == reflect.Pointer {
 		rv = rv.Elem()
 	}
-	m := make(map[string]any, len(s.fieldByKey))
-	for key, idx := range s.fieldByKey {
-		fv := rv.Field(idx)
-		if !fv.IsValid() {
-			continue
-		}
-		// Treat zero values as present to avoid false required errors for typed values
-		m[key] = fv.Interface()
-	}
+	m := s.plan.toMap(rv)
 	return s.inner.ValidateValue(ctx, m)
 }
 
 func (s *typedObjectSchema[T]) JSONSchema() (*js.Schema, error) { return s.inner.JSONSchema() }
+func (s *typedObjectSchema[T]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	return s.inner.exportJSONSchema(ex)
+}
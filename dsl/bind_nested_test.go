@@ -0,0 +1,126 @@
+package dsl_test
+
+import (
+	"context"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type orderItem struct {
+	SKU string `json:"sku"`
+	Qty int    `json:"qty"`
+}
+
+type orderAddress struct {
+	City string `json:"city"`
+}
+
+type order struct {
+	ID       string                   `json:"id"`
+	Items    []orderItem              `json:"items"`
+	ByWH     map[string]orderItem     `json:"byWarehouse"`
+	Ship     *orderAddress            `json:"ship"`
+	Bill     orderAddress             `json:"bill"`
+	Previous []*orderItem             `json:"previous"`
+	Labels   map[string]string        `json:"labels"`
+	Extra    map[string]*orderAddress `json:"extra"`
+}
+
+func orderSchema(t *testing.T) goskema.Schema[order] {
+	t.Helper()
+	item := g.Object().
+		Field("sku", g.StringOf[string]()).Required().
+		Field("qty", g.IntOf[int]()).Required().
+		MustBuild()
+	addr := g.Object().Field("city", g.StringOf[string]()).Required().MustBuild()
+	s, err := g.ObjectOf[order]().
+		Field("id", g.StringOf[string]()).Required().
+		Field("items", g.ArrayOf[map[string]any](item)).
+		Field("byWarehouse", g.MapOf[map[string]any](item)).
+		Field("ship", g.SchemaOf(addr).Nullable()).
+		Field("bill", g.SchemaOf(addr)).
+		Field("previous", g.ArrayOf[map[string]any](item)).
+		Field("labels", g.MapOf[string](g.String())).
+		Field("extra", g.MapOf[map[string]any](addr)).
+		Bind()
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	return s
+}
+
+func TestBind_NestedProjection(t *testing.T) {
+	ctx := context.Background()
+	s := orderSchema(t)
+	in := map[string]any{
+		"id":          "o1",
+		"items":       []any{map[string]any{"sku": "a", "qty": 1}, map[string]any{"sku": "b", "qty": 2}},
+		"byWarehouse": map[string]any{"tokyo": map[string]any{"sku": "c", "qty": 3}},
+		"ship":        map[string]any{"city": "Osaka"},
+		"bill":        map[string]any{"city": "Kyoto"},
+		"previous":    []any{map[string]any{"sku": "p", "qty": 9}},
+		"labels":      map[string]any{"k": "v"},
+		"extra":       map[string]any{"x": map[string]any{"city": "Nara"}},
+	}
+	v, err := s.Parse(ctx, in)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(v.Items) != 2 || v.Items[1].SKU != "b" || v.Items[1].Qty != 2 {
+		t.Fatalf("items: %+v", v.Items)
+	}
+	if v.ByWH["tokyo"].Qty != 3 || v.Ship == nil || v.Ship.City != "Osaka" || v.Bill.City != "Kyoto" {
+		t.Fatalf("unexpected: %+v", v)
+	}
+	if v.Previous[0] == nil || v.Previous[0].SKU != "p" || v.Labels["k"] != "v" || v.Extra["x"].City != "Nara" {
+		t.Fatalf("unexpected: %+v", v)
+	}
+
+	in["ship"] = nil
+	if v, err := s.Parse(ctx, in); err != nil || v.Ship != nil {
+		t.Fatalf("nullable pointer: %+v %v", v, err)
+	}
+
+	// ValidateValue projects the struct tree back into the wire shape
+	if err := s.ValidateValue(ctx, v); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	v.Items[0].Qty = 0
+	v.Bill.City = ""
+	if err := s.ValidateValue(ctx, v); err != nil {
+		t.Fatalf("zero values are present: %v", err)
+	}
+}
+
+func TestBind_TypeMismatchAtBindTime(t *testing.T) {
+	type bad struct {
+		Items []string `json:"items"`
+		Count string   `json:"count"`
+	}
+	item := g.Object().Field("sku", g.StringOf[string]()).MustBuild()
+	_, err := g.ObjectOf[bad]().
+		Field("items", g.ArrayOf[map[string]any](item)).
+		Field("count", g.IntOf[int]()).
+		Bind()
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 2 {
+		t.Fatalf("expected two bind-time issues, got %v", err)
+	}
+	if iss[0].Path != "/count" || iss[1].Path != "/items/*" || iss[0].Code != goskema.CodeInvalidType {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	type nested struct {
+		Sub struct {
+			N string `json:"n"`
+		} `json:"sub"`
+	}
+	sub := g.Object().Field("n", g.IntOf[int]()).MustBuild()
+	_, err = g.ObjectOf[nested]().Field("sub", g.SchemaOf(sub)).Bind()
+	iss, _ = goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/sub/n" {
+		t.Fatalf("expected nested mismatch at /sub/n: %+v", iss)
+	}
+}
@@ -0,0 +1,140 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type userPatch struct {
+	ID       string                         `json:"id"`
+	Nickname goskema.Optional[string]       `json:"nickname,omitzero"`
+	Age      goskema.Optional[int]          `json:"age,omitzero"`
+	Address  goskema.Optional[orderAddress] `json:"address,omitzero"`
+}
+
+func userPatchSchema(t *testing.T) goskema.Schema[userPatch] {
+	t.Helper()
+	addr := g.Object().Field("city", g.StringOf[string]()).Required().MustBuild()
+	nickname := goskema.FieldOf[userPatch](func(p *userPatch) *goskema.Optional[string] { return &p.Nickname })
+	s, err := g.ObjectOf[userPatch]().
+		Field("id", g.StringOf[string]()).Required().
+		Field("nickname", g.StringOf[string]().Nullable()).
+		Field("age", g.IntOf[int]().Nullable()).
+		Field("address", g.SchemaOf(addr).Nullable()).Optional().
+		RefineCtx("nickname-token", func(dc goskema.DomainCtx[userPatch], p userPatch) []goskema.Issue {
+			if dc.WasNull(nickname) != p.Nickname.IsNull() || dc.Seen(nickname) != p.Nickname.Present() {
+				return []goskema.Issue{{Path: "/nickname", Code: goskema.CodeBusinessRule, Message: "presence mismatch"}}
+			}
+			return nil
+		}).
+		Bind()
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	return s
+}
+
+func TestBind_OptionalStates(t *testing.T) {
+	s := userPatchSchema(t)
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"id":"u1","nickname":null,"address":{"city":"Kyoto"}}`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := dm.Value
+	if !p.Nickname.IsNull() || !p.Age.IsMissing() {
+		t.Fatalf("unexpected states: nickname=%v age=%v", p.Nickname.State(), p.Age.State())
+	}
+	if a, ok := p.Address.Get(); !ok || a.City != "Kyoto" {
+		t.Fatalf("unexpected address: %+v", p.Address)
+	}
+
+	p2, err := s.Parse(context.Background(), map[string]any{"id": "u1", "age": 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p2.Age.OrElse(0) != 7 || !p2.Nickname.IsMissing() {
+		t.Fatalf("unexpected states: %+v", p2)
+	}
+}
+
+func TestBind_OptionalRoundTrip(t *testing.T) {
+	s := userPatchSchema(t)
+	in := `{"id":"u1","nickname":null,"age":3}`
+	p, err := goskema.ParseFrom(context.Background(), s, goskema.JSONBytes([]byte(in)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != in {
+		t.Fatalf("round trip mismatch: got %s want %s", out, in)
+	}
+	if err := s.ValidateValue(context.Background(), p); err != nil {
+		t.Fatalf("unexpected validate error: %v", err)
+	}
+	if err := s.ValidateValue(context.Background(), userPatch{ID: "u1", Address: goskema.Null[orderAddress]()}); err != nil {
+		t.Fatalf("missing and null optionals should validate: %v", err)
+	}
+}
+
+func TestBind_OptionalPreservingRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := userPatchSchema(t)
+	// nickname is null, age is set, address is missing
+	dm, err := goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"id":"u1","nickname":null,"age":3}`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := goskema.EncodePreservingToMap(ctx, s, dm)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	want := map[string]any{"id": "u1", "nickname": nil, "age": 3}
+	if !reflect.DeepEqual(out, want) {
+		t.Fatalf("got  %#v\nwant %#v", out, want)
+	}
+
+	// a null object and a missing scalar
+	dm, err = goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"id":"u1","address":null}`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err = goskema.EncodePreservingToMap(ctx, s, dm)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if want := map[string]any{"id": "u1", "address": nil}; !reflect.DeepEqual(out, want) {
+		t.Fatalf("got  %#v\nwant %#v", out, want)
+	}
+}
+
+func TestBind_OptionalTypeMismatch(t *testing.T) {
+	_, err := g.ObjectOf[userPatch]().
+		Field("nickname", g.IntOf[int]()).
+		Bind()
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) == 0 || iss[0].Path != "/nickname" {
+		t.Fatalf("expected bind error at /nickname, got %v", err)
+	}
+}
+
+func TestFromStruct_Optional(t *testing.T) {
+	type patch struct {
+		Name goskema.Optional[string] `json:"name,omitzero" goskema:"min=1"`
+	}
+	s := g.FromStruct[patch]().MustBind()
+	p, err := s.Parse(context.Background(), map[string]any{"name": nil})
+	if err != nil || !p.Name.IsNull() {
+		t.Fatalf("expected null name, got %+v err=%v", p, err)
+	}
+	if _, err := s.Parse(context.Background(), map[string]any{"name": ""}); err == nil {
+		t.Fatal("expected min length error")
+	}
+}
@@ -0,0 +1,391 @@
+package dsl
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+)
+
+// projKind selects how an adapter output value is projected into a Go field.
+type projKind int
+
+const (
+	projDirect   projKind = iota // assignable or same-kind convertible
+	projDynamic                  // adapter output type unknown (any); checked at runtime
+	projPointer                  // *X field; elem projects into X
+	projStruct                   // struct field from map[string]any via a nested object schema
+	projSlice                    // []X field; elem projects each element
+	projMap                      // map[string]X field; elem projects each value
+	projOptional                 // goskema.Optional[X] field; elem projects X, null and missing map to states
+)
+
+// fieldProjector converts between an adapter's output (out) and a Go field type (ft).
+// It is planned once at Bind time so that type mismatches surface before any input is parsed.
+type fieldProjector struct {
+	kind projKind
+	ft   reflect.Type
+	out  reflect.Type
+	elem *fieldProjector
+	obj  *structPlan
+}
+
+// structPlan maps object keys to struct fields of t.
+type structPlan struct {
+	t      reflect.Type
+	fields []planField
+}
+
+type planField struct {
+	key   string
+	index []int
+	proj  *fieldProjector
+}
+
+var (
+	mapStringAnyType  = reflect.TypeOf(map[string]any{})
+	optionalFieldType = reflect.TypeOf((*goskema.OptionalField)(nil)).Elem()
+)
+
+// planStruct resolves struct keys (json/goskema tags) against the object's fields and plans a
+// projector for each. Mismatches are reported under "/key".
+func planStruct(rt reflect.Type, o *objectSchema) (*structPlan, goskema.Issues) {
+	idxByName := make(map[string][]int)
+	structKeyIndex(rt, nil, idxByName)
+	plan := &structPlan{t: rt}
+	var iss goskema.Issues
+	for _, k := range o.sortedKnownKeys() {
+		i, ok := idxByName[k]
+		if !ok {
+			continue
+		}
+		ad := o.fields[k]
+		proj, perr := planValue(rt.FieldByIndex(i).Type, ad.out, ad.orig)
+		if len(perr) > 0 {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, perr)...)
+			continue
+		}
+		plan.fields = append(plan.fields, planField{key: k, index: i, proj: proj})
+	}
+	return plan, iss
+}
+
+// structKeyIndex records the field index path of each struct key. Fields of embedded structs
+// without an explicit key are promoted like encoding/json; outer fields win.
+func structKeyIndex(rt reflect.Type, base []int, out map[string][]int) {
+	var embedded []reflect.StructField
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if isPromotedStruct(sf) {
+			embedded = append(embedded, sf)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		name := goskema.ResolveStructKey(sf)
+		if name == "-" || name == "" {
+			continue
+		}
+		out[name] = append(append([]int(nil), base...), i)
+	}
+	for _, sf := range embedded {
+		inner := make(map[string][]int)
+		structKeyIndex(sf.Type, append(append([]int(nil), base...), sf.Index...), inner)
+		for k, idx := range inner {
+			if _, ok := out[k]; !ok {
+				out[k] = idx
+			}
+		}
+	}
+}
+
+// isPromotedStruct reports an embedded struct (not pointer) whose fields are flattened.
+func isPromotedStruct(sf reflect.StructField) bool {
+	if !sf.Anonymous || sf.Type.Kind() != reflect.Struct {
+		return false
+	}
+	if jt := sf.Tag.Get("json"); jt != "" && !strings.HasPrefix(jt, ",") {
+		return false
+	}
+	return !strings.Contains(sf.Tag.Get("goskema"), "name=")
+}
+
+// elemSchemaOf returns the element schema of an array/map schema producing out, or nil.
+func elemSchemaOf(orig any, out reflect.Type) any {
+	es, ok := orig.(interface{ elemSchema() any })
+	if !ok {
+		return nil
+	}
+	if m := reflect.ValueOf(orig).MethodByName("Parse"); !m.IsValid() || m.Type().Out(0) != out {
+		return nil
+	}
+	return es.elemSchema()
+}
+
+func planValue(ft, out reflect.Type, orig any) (*fieldProjector, goskema.Issues) {
+	p := &fieldProjector{ft: ft, out: out}
+	switch {
+	case ft.Kind() == reflect.Struct && ft.Implements(optionalFieldType) && out != ft:
+		et := reflect.Zero(ft).Interface().(goskema.OptionalField).OptionalElemType()
+		elem, iss := planValue(et, out, orig)
+		if len(iss) > 0 {
+			return nil, iss
+		}
+		p.kind, p.elem = projOptional, elem
+		return p, nil
+	case out == nil || out.Kind() == reflect.Interface:
+		p.kind = projDynamic
+		return p, nil
+	case out.AssignableTo(ft) || sameKindConvertible(out, ft):
+		p.kind = projDirect
+		return p, nil
+	case ft.Kind() == reflect.Pointer:
+		elem, iss := planValue(ft.Elem(), out, orig)
+		if len(iss) > 0 {
+			return nil, iss
+		}
+		p.kind, p.elem = projPointer, elem
+		return p, nil
+	case ft.Kind() == reflect.Struct && out == mapStringAnyType:
+		if o, ok := orig.(*objectSchema); ok {
+			plan, iss := planStruct(ft, o)
+			if len(iss) > 0 {
+				return nil, iss
+			}
+			p.kind, p.obj = projStruct, plan
+			return p, nil
+		}
+	case ft.Kind() == reflect.Slice && out.Kind() == reflect.Slice:
+		elem, iss := planValue(ft.Elem(), out.Elem(), elemSchemaOf(orig, out))
+		if len(iss) > 0 {
+			return nil, rebaseIssuesUnder("/*", iss)
+		}
+		p.kind, p.elem = projSlice, elem
+		return p, nil
+	case ft.Kind() == reflect.Map && out.Kind() == reflect.Map && ft.Key().Kind() == reflect.String && out.Key().Kind() == reflect.String:
+		elem, iss := planValue(ft.Elem(), out.Elem(), elemSchemaOf(orig, out))
+		if len(iss) > 0 {
+			return nil, rebaseIssuesUnder("/*", iss)
+		}
+		p.kind, p.elem = projMap, elem
+		return p, nil
+	}
+	return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "field type " + ft.String() + " does not match adapter output " + out.String(), Params: map[string]any{"field": ft.String(), "adapter": out.String()}}}
+}
+
+// sameKindConvertible allows conversions between named and underlying types of the same kind
+// family (e.g. string -> Status, int64 -> Duration), but not int -> string.
+func sameKindConvertible(from, to reflect.Type) bool {
+	if !from.ConvertibleTo(to) {
+		return false
+	}
+	return kindFamily(from.Kind()) != 0 && kindFamily(from.Kind()) == kindFamily(to.Kind())
+}
+
+func kindFamily(k reflect.Kind) int {
+	switch k {
+	case reflect.String:
+		return 1
+	case reflect.Bool:
+		return 2
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return 3
+	}
+	return 0
+}
+
+// project converts a parsed adapter value into a value of the field type.
+func (p *fieldProjector) project(val any) (reflect.Value, goskema.Issues) {
+	if p.kind == projOptional {
+		opt := reflect.New(p.ft)
+		setter := opt.Interface().(interface {
+			SetOptional(goskema.OptionalState, any) bool
+		})
+		if val == nil {
+			setter.SetOptional(goskema.OptionalNull, nil)
+			return opt.Elem(), nil
+		}
+		ev, iss := p.elem.project(val)
+		if len(iss) > 0 {
+			return reflect.Value{}, iss
+		}
+		if !setter.SetOptional(goskema.OptionalSet, ev.Interface()) {
+			return reflect.Value{}, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "field type mismatch"}}
+		}
+		return opt.Elem(), nil
+	}
+	if val == nil {
+		return reflect.Zero(p.ft), nil
+	}
+	rv := reflect.ValueOf(val)
+	switch p.kind {
+	case projPointer:
+		if rv.Type().AssignableTo(p.ft) {
+			return rv, nil
+		}
+		ev, iss := p.elem.project(val)
+		if len(iss) > 0 {
+			return reflect.Value{}, iss
+		}
+		ptr := reflect.New(p.ft.Elem())
+		ptr.Elem().Set(ev)
+		return ptr, nil
+	case projStruct:
+		m, ok := val.(map[string]any)
+		if !ok {
+			break
+		}
+		return p.obj.build(m)
+	case projSlice:
+		if rv.Kind() != reflect.Slice {
+			break
+		}
+		out := reflect.MakeSlice(p.ft, rv.Len(), rv.Len())
+		var iss goskema.Issues
+		for i := 0; i < rv.Len(); i++ {
+			ev, eiss := p.elem.project(rv.Index(i).Interface())
+			if len(eiss) > 0 {
+				iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+strconv.Itoa(i), eiss)...)
+				continue
+			}
+			out.Index(i).Set(ev)
+		}
+		if len(iss) > 0 {
+			return reflect.Value{}, iss
+		}
+		return out, nil
+	case projMap:
+		if rv.Kind() != reflect.Map {
+			break
+		}
+		out := reflect.MakeMapWithSize(p.ft, rv.Len())
+		var iss goskema.Issues
+		it := rv.MapRange()
+		for it.Next() {
+			k := it.Key().String()
+			ev, eiss := p.elem.project(it.Value().Interface())
+			if len(eiss) > 0 {
+				iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, eiss)...)
+				continue
+			}
+			out.SetMapIndex(reflect.ValueOf(k).Convert(p.ft.Key()), ev)
+		}
+		if len(iss) > 0 {
+			return reflect.Value{}, iss
+		}
+		return out, nil
+	default:
+		if rv.Type().AssignableTo(p.ft) {
+			return rv, nil
+		}
+		if rv.Type().ConvertibleTo(p.ft) {
+			return rv.Convert(p.ft), nil
+		}
+	}
+	return reflect.Value{}, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "field type mismatch"}}
+}
+
+// wire converts a field value back into the adapter's output shape (for ValidateValue).
+// ok is false for nil pointers and missing Optional fields, which are treated as absent.
+func (p *fieldProjector) wire(fv reflect.Value) (any, bool) {
+	switch p.kind {
+	case projOptional:
+		v, st := fv.Interface().(goskema.OptionalField).OptionalValue()
+		switch st {
+		case goskema.OptionalMissing:
+			return nil, false
+		case goskema.OptionalNull:
+			return nil, true
+		}
+		return p.elem.wire(reflect.ValueOf(v))
+	case projPointer:
+		if fv.IsNil() {
+			return nil, false
+		}
+		if fv.Type().AssignableTo(p.out) {
+			return fv.Interface(), true
+		}
+		return p.elem.wire(fv.Elem())
+	case projStruct:
+		return p.obj.toMap(fv), true
+	case projSlice:
+		if fv.IsNil() {
+			return nil, true
+		}
+		out := reflect.MakeSlice(p.out, fv.Len(), fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			if ev, ok := p.elem.wire(fv.Index(i)); ok && ev != nil {
+				out.Index(i).Set(reflect.ValueOf(ev))
+			}
+		}
+		return out.Interface(), true
+	case projMap:
+		if fv.IsNil() {
+			return nil, true
+		}
+		out := reflect.MakeMapWithSize(p.out, fv.Len())
+		it := fv.MapRange()
+		for it.Next() {
+			if ev, ok := p.elem.wire(it.Value()); ok && ev != nil {
+				out.SetMapIndex(reflect.ValueOf(it.Key().String()).Convert(p.out.Key()), reflect.ValueOf(ev))
+			}
+		}
+		return out.Interface(), true
+	case projDirect:
+		if !fv.Type().AssignableTo(p.out) && fv.Type().ConvertibleTo(p.out) {
+			return fv.Convert(p.out).Interface(), true
+		}
+	}
+	return fv.Interface(), true
+}
+
+// build constructs a struct value from a parsed object map.
+func (sp *structPlan) build(m map[string]any) (reflect.Value, goskema.Issues) {
+	rv := reflect.New(sp.t).Elem()
+	var iss goskema.Issues
+	for _, f := range sp.fields {
+		val, ok := m[f.key]
+		if !ok {
+			continue
+		}
+		fv := rv.FieldByIndex(f.index)
+		if !fv.CanSet() {
+			continue
+		}
+		pv, piss := f.proj.project(val)
+		if len(piss) > 0 {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+f.key, piss)...)
+			continue
+		}
+		fv.Set(pv)
+	}
+	if len(iss) > 0 {
+		return reflect.Value{}, iss
+	}
+	return rv, nil
+}
+
+// toMap converts a struct value into the wire map shape; nil pointer fields and missing
+// Optional fields are omitted.
+func (sp *structPlan) toMap(rv reflect.Value) map[string]any {
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	m := make(map[string]any, len(sp.fields))
+	for _, f := range sp.fields {
+		fv := rv.FieldByIndex(f.index)
+		if !fv.IsValid() {
+			continue
+		}
+		// Treat zero values as present to avoid false required errors for typed values
+		if v, ok := f.proj.wire(fv); ok {
+			m[f.key] = v
+		}
+	}
+	return m
+}
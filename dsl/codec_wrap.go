@@ -17,50 +17,58 @@ func Codec[A, B any](c goskema.Codec[A, B]) goskema.Schema[B] { return codecSche
 type codecSchema[A, B any] struct{ c goskema.Codec[A, B] }
 
 func (s codecSchema[A, B]) Parse(ctx context.Context, v any) (B, error) {
+	d, err := s.decode(ctx, v, false)
+	return d.Value, err
+}
+
+// ParseWithMeta reports the presence returned by the codec's DecodeWithMeta (e.g. WasNull from
+// codec.Nullable), so composed codecs keep their presence when used as a schema.
+func (s codecSchema[A, B]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[B], error) {
+	d, err := s.decode(ctx, v, true)
+	if d.Presence == nil {
+		d.Presence = goskema.PresenceMap{}
+	}
+	d.Presence["/"] |= goskema.PresenceSeen
+	return d, err
+}
+
+func (s codecSchema[A, B]) decode(ctx context.Context, v any, meta bool) (goskema.Decoded[B], error) {
+	var zero goskema.Decoded[B]
 	// wire -> A
 	a, err := s.c.In().Parse(ctx, v)
 	if err != nil {
-		var zero B
-		if iss, ok := goskema.AsIssues(err); ok {
-			return zero, iss
-		}
-		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		return zero, codecIssues(err)
 	}
 	// A -> B
-	b, err := s.c.Decode(ctx, a)
+	var d goskema.Decoded[B]
+	if meta {
+		d, err = s.c.DecodeWithMeta(ctx, a)
+	} else {
+		d.Value, err = s.c.Decode(ctx, a)
+	}
 	if err != nil {
-		var zero B
-		if iss, ok := goskema.AsIssues(err); ok {
-			return zero, iss
-		}
-		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		return zero, codecIssues(err)
 	}
 	// Normalize -> ValidateValue -> Refine on Out schema
-	b2, err := goskema.ApplyNormalize[B](ctx, b, s.c.Out())
+	b2, err := goskema.ApplyNormalize[B](ctx, d.Value, s.c.Out())
 	if err != nil {
-		var zero B
-		if iss, ok := goskema.AsIssues(err); ok {
-			return zero, iss
-		}
-		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		return zero, codecIssues(err)
 	}
 	if err := s.c.Out().ValidateValue(ctx, b2); err != nil {
-		var zero B
 		return zero, err
 	}
 	if err := goskema.ApplyRefine[B](ctx, b2, s.c.Out()); err != nil {
-		var zero B
-		if iss, ok := goskema.AsIssues(err); ok {
-			return zero, iss
-		}
-		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		return zero, codecIssues(err)
 	}
-	return b2, nil
+	d.Value = b2
+	return d, nil
 }
 
-func (s codecSchema[A, B]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[B], error) {
-	b, err := s.Parse(ctx, v)
-	return goskema.Decoded[B]{Value: b, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+func codecIssues(err error) error {
+	if iss, ok := goskema.AsIssues(err); ok {
+		return iss
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
 }
 
 func (s codecSchema[A, B]) TypeCheck(ctx context.Context, v any) error {
@@ -76,3 +84,6 @@ func (s codecSchema[A, B]) ValidateValue(ctx context.Context, v B) error {
 	return s.c.Out().ValidateValue(ctx, v)
 }
 func (s codecSchema[A, B]) JSONSchema() (*js.Schema, error) { return s.c.Out().JSONSchema() }
+func (s codecSchema[A, B]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	return exportJSONSchema(s.c.Out(), ex)
+}
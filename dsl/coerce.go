@@ -0,0 +1,164 @@
+package dsl
+
+import (
+	"encoding/json"
+	"math"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+)
+
+// Coerce converts string inputs (query strings, form values, headers, env vars, CSV cells) into
+// the wire shape expected by the adapter's Go type before parsing:
+//   - bool: strconv.ParseBool plus "on"/"off"/"yes"/"no"
+//   - integers, floats, json.Number: the decimal text becomes a json.Number (bounds are still
+//     checked by the schema)
+//   - slices: a single string is split on commas, repeated keys ([]string) map element-wise
+//   - string, time.Time and other types: passed through to the schema unchanged
+//
+// Non-string inputs are passed through, so JSON bodies keep working. Failures are reported as
+// invalid_type with Params["input"] holding the original string; a successful conversion records
+// PresenceCoerced. Adapters without a known Go type are returned unchanged.
+// Example: Field("limit", g.IntOf[int]().Coerce())
+func (ad AnyAdapter) Coerce() AnyAdapter {
+	t := ad.out
+	if t == nil {
+		return ad
+	}
+	return ad.preStage("coerce", goskema.PresenceCoerced, func(v any) (any, error) { return coerceInput(t, v) })
+}
+
+// Values flattens url.Values into a parse input: keys with a single value map to a string,
+// repeated keys to []string. Combine with Coerce on the fields.
+func Values(vs url.Values) map[string]any {
+	out := make(map[string]any, len(vs))
+	for k, v := range vs {
+		switch len(v) {
+		case 0:
+		case 1:
+			out[k] = v[0]
+		default:
+			out[k] = append([]string(nil), v...)
+		}
+	}
+	return out
+}
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+func coerceInput(t reflect.Type, v any) (any, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch in := v.(type) {
+	case string:
+		return coerceString(t, in, "/")
+	case []string:
+		if !isCoercibleList(t) {
+			if len(in) == 1 {
+				return coerceString(t, in[0], "/")
+			}
+			return nil, goskema.Issues{coerceIssue("/", strings.Join(in, ","), "a single value")}
+		}
+		return coerceList(t.Elem(), in)
+	}
+	return v, nil
+}
+
+func isCoercibleList(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() != reflect.Uint8
+}
+
+func coerceList(elem reflect.Type, parts []string) (any, error) {
+	out := make([]any, len(parts))
+	var iss goskema.Issues
+	for i, p := range parts {
+		ev, err := coerceString(elem, strings.TrimSpace(p), "/"+strconv.Itoa(i))
+		if err != nil {
+			iss = goskema.AppendIssues(iss, issuesFromErr("/"+strconv.Itoa(i), err)...)
+			continue
+		}
+		out[i] = ev
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return out, nil
+}
+
+func coerceString(t reflect.Type, s string, path string) (any, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == jsonNumberType {
+		return coerceFloat(s, path)
+	}
+	if t == timeType {
+		return s, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "on", "yes":
+			return true, nil
+		case "off", "no":
+			return false, nil
+		}
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return nil, goskema.Issues{coerceIssue(path, s, "boolean")}
+		}
+		return b, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, goskema.Issues{coerceIssue(path, s, "integer")}
+		}
+		return json.Number(strconv.FormatInt(n, 10)), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, goskema.Issues{coerceIssue(path, s, "integer")}
+		}
+		return json.Number(strconv.FormatUint(n, 10)), nil
+	case reflect.Float32, reflect.Float64:
+		return coerceFloat(s, path)
+	case reflect.Slice, reflect.Array:
+		if !isCoercibleList(t) {
+			return s, nil
+		}
+		if strings.TrimSpace(s) == "" {
+			return []any{}, nil
+		}
+		return coerceList(t.Elem(), strings.Split(s, ","))
+	}
+	return s, nil
+}
+
+// coerceFloat keeps the decimal text when it is a valid JSON number (no precision loss) and
+// rejects NaN/Inf.
+func coerceFloat(s, path string) (any, error) {
+	text := strings.TrimSpace(s)
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, goskema.Issues{coerceIssue(path, s, "number")}
+	}
+	if !json.Valid([]byte(text)) {
+		text = strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return json.Number(text), nil
+}
+
+func coerceIssue(path, input, expected string) goskema.Issue {
+	return goskema.Issue{
+		Path:    path,
+		Code:    goskema.CodeInvalidType,
+		Message: i18n.T(goskema.CodeInvalidType, nil),
+		Hint:    "cannot coerce string to " + expected,
+		Params:  map[string]any{"input": input, "expected": expected},
+	}
+}
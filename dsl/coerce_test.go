@@ -0,0 +1,91 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestCoerce_QueryValues(t *testing.T) {
+	type query struct {
+		Limit  int           `json:"limit"`
+		Ratio  float64       `json:"ratio"`
+		Active bool          `json:"active"`
+		IDs    []json.Number `json:"ids"`
+		Tags   []string      `json:"tags"`
+		Q      string        `json:"q"`
+	}
+	s := g.ObjectOf[query]().
+		Field("limit", g.IntOf[int]().Coerce()).Required().
+		Field("ratio", g.FloatOf[float64]().Coerce()).
+		Field("active", g.BoolOf[bool]().Coerce()).
+		Field("ids", g.ArrayOf[json.Number](g.NumberJSON()).Coerce()).
+		Field("tags", g.ArrayOf[string](g.String()).Coerce()).
+		Field("q", g.StringOf[string]().Coerce()).
+		MustBind()
+
+	in, _ := url.ParseQuery("limit=10&ratio=0.5&active=on&ids=1,2,3&tags=a&tags=b&q=007")
+	v, err := s.Parse(context.Background(), g.Values(in))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if v.Limit != 10 || v.Ratio != 0.5 || !v.Active || len(v.IDs) != 3 || v.IDs[2] != "3" || len(v.Tags) != 2 || v.Q != "007" {
+		t.Fatalf("unexpected value: %+v", v)
+	}
+
+	// JSON bodies keep working
+	if v, err := s.Parse(context.Background(), map[string]any{"limit": 5, "ids": []any{json.Number("1")}}); err != nil || v.Limit != 5 {
+		t.Fatalf("unexpected result: %+v %v", v, err)
+	}
+}
+
+func TestCoerce_FailureKeepsInput(t *testing.T) {
+	s := g.Object().
+		Field("limit", g.IntOf[int]().Coerce()).
+		Field("ids", g.ArrayOf[json.Number](g.NumberJSON()).Coerce()).
+		MustBuild()
+	_, err := s.Parse(context.Background(), map[string]any{"limit": "ten", "ids": "1,x"})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 2 {
+		t.Fatalf("expected two issues, got %v", err)
+	}
+	byPath := map[string]goskema.Issue{}
+	for _, it := range iss {
+		byPath[it.Path] = it
+	}
+	if it := byPath["/limit"]; it.Code != goskema.CodeInvalidType || it.Params["input"] != "ten" {
+		t.Fatalf("unexpected /limit issue: %+v", it)
+	}
+	if it := byPath["/ids/1"]; it.Code != goskema.CodeInvalidType || it.Params["input"] != "x" {
+		t.Fatalf("unexpected /ids/1 issue: %+v", it)
+	}
+
+	_, err = s.Parse(context.Background(), g.Values(url.Values{"limit": {"1", "2"}}))
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/limit" || iss[0].Code != goskema.CodeInvalidType {
+		t.Fatalf("expected invalid_type for repeated scalar, got %v", err)
+	}
+}
+
+func TestCoerce_PresenceCoerced(t *testing.T) {
+	s := g.Object().
+		Field("a", g.IntOf[int]().Coerce()).
+		Field("b", g.IntOf[int]().Coerce()).
+		MustBuild()
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"a":"10","b":20}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Value["a"] != 10 || dm.Value["b"] != 20 {
+		t.Fatalf("unexpected values: %#v", dm.Value)
+	}
+	if dm.Presence["/a"]&goskema.PresenceCoerced == 0 || dm.Presence["/b"]&goskema.PresenceCoerced != 0 {
+		t.Fatalf("unexpected presence: %v", dm.Presence)
+	}
+	if dm.Presence["/a"]&goskema.PresenceTransformed != 0 {
+		t.Fatalf("coercion must not be reported as a transformation: %v", dm.Presence)
+	}
+}
@@ -4,6 +4,8 @@
 //   - Builder API: declare JSON object semantics (unknown/required/default/refine) with Object()/Field()/Required()/UnknownStrict()/MustBuild().
 //   - Typed build: generate a safe projection wire -> T with ObjectOf[T]().Field(...).MustBind().
 //   - Primitives/Array/Map: String()/Bool()/NumberJSON(), Array(elem), Map(elem) are provided.
+//     String() returns a StringBuilder with MinLen/MaxLen/Pattern/OneOf/StartsWith/EndsWith/Format.
+//     NumberJSON() returns a NumberBuilder with Min/Max/ExclusiveMin/ExclusiveMax/MultipleOf (exact json.Number comparison).
 //   - AnyAdapter: adapt existing Schema[T] to AnyAdapter via `SchemaOf[T](s)` to embed into builders.
 //   - Presence: obtain missing/wasNull/defaultApplied as a JSON Pointer-based map via ParseFromWithMeta/DecodeWithMeta.
 //   - Streaming: stage-wise validation driven by Source for huge arrays and deep nesting (Array/Object have streaming implementations).
@@ -16,14 +18,32 @@
 //   - SchemaOf[T](s): adapter from Schema[T] to AnyAdapter (to pass into Field).
 //
 // File layout (roles)
-//   - presence_helpers.go: common helpers for Presence collection (markPresenceSubtree).
+//   - presence_helpers.go: common helpers for Presence collection (markPresenceSubtree, parseWithPresence).
+//   - string.go: StringBuilder constraints (length/pattern/enum/affix) and StringOfSchema.
+//   - from_struct.go: FromStruct derives typed object schemas from json/goskema struct tags.
+//   - enum.go: Enum/EnumOf for Go string/int enum types (optional case-insensitive match).
+//   - lazy.go: Lazy/LazyNamed/LazySchema for recursive schemas ($defs/$ref export).
+//   - literal.go: Const/Literal schemas that accept exactly one value (JSON Schema const).
+//   - number.go: NumberBuilder range bounds and the IntOfSchema/FloatOfSchema/... family.
 //   - array_core.go: normal path for ArraySchema (Parse/Validate/JSONSchema).
 //   - array_stream.go: streaming parse for ArraySchema (ParseFromSource*).
+//   - array_unique.go: Unique/UniqueBy and typed Contains for ArraySchema (per-element checks).
 //   - map_core.go: implementations for MapAny/Map[V] (normal/streaming).
+//   - map_kv.go: MapKV with key schemas (typed keys, enums, patterns) and MinProps/MaxProps.
 //   - object_builder.go: objectBuilder/fieldStep and Build/MustBuild, OneOf/Variant APIs.
+//   - object_derive.go: Derive/DeriveOf and Extend/Merge/Pick/Omit/Partial/DeepPartial derivations.
+//   - object_default.go: computed defaults (DefaultFunc/DefaultFrom).
+//   - object_conditional.go: conditional presence (RequiredIf/DependentRequired/MutuallyExclusive/RequireOneOf).
+//   - object_access.go: ReadOnly/WriteOnly/Immutable field modes keyed on the request operation.
 //   - object_core.go: normal path for objectSchema (Parse/ParseWithMeta/Validate/JSONSchema).
 //   - object_stream.go: streaming for objectSchema (handling unknowns, rebasing error paths, helpers).
+//   - intersect.go: AllOf/Intersect merging object schemas (shared fields, unknown policy, conflicts).
+//   - tuple.go: positional arrays (Tuple/Rest) with prefixItems export and streaming.
 //   - union.go: simple Union schema based on discriminator.
+//   - union_shape.go: non-discriminated Union (first-match/oneOf/best-score) with closest-branch issues.
+//   - transform.go: field-level Preprocess/Transform stages and PresenceTransformed tracking.
+//   - coerce.go: string coercion for query/form/env inputs (Coerce, Values).
+//   - bind_project.go: Bind-time projection plans for nested structs, slices, maps and pointers.
 //   - (aux) adapter.go/of_helpers.go/object_typed_builder.go around AnyAdapter and typed binding.
 //
 // Design guidelines
@@ -0,0 +1,191 @@
+package dsl
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+)
+
+// encodeValue converts a parsed value back into its wire shape. nil and values of adapters
+// without an encode direction are returned as-is.
+func (ad AnyAdapter) encodeValue(ctx context.Context, v any) (any, error) {
+	if ad.encode == nil || v == nil {
+		return v, nil
+	}
+	return ad.encode(ctx, v)
+}
+
+// isNilValue reports nil and nil pointers, slices and maps.
+func isNilValue(v any) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// encodeWith encodes v via s when s implements goskema.WireEncoder.
+func encodeWith[T any](ctx context.Context, s goskema.Schema[T], v T) (any, error) {
+	if we, ok := any(s).(goskema.WireEncoder[T]); ok {
+		return we.EncodeWire(ctx, v)
+	}
+	return v, nil
+}
+
+// EncodeWire implements goskema.WireEncoder: known fields are encoded by their adapters in key
+// order and missing fields with a static default get it (not under goskema.EncodePreserve,
+// where missing fields stay missing). Nil values (including nil pointers, slices and maps) are
+// written as null when the field accepts null and omitted otherwise.
+// Write-only fields are omitted; under UnknownPassthrough the collected unknown keys are
+// written back at the top level.
+func (o *objectSchema) EncodeWire(ctx context.Context, m map[string]any) (any, error) {
+	out := make(map[string]any, len(m))
+	var iss goskema.Issues
+	preserve := goskema.EncodeModeFrom(ctx) == goskema.EncodePreserve
+	for _, k := range o.sortedKnownKeys() {
+		ad := o.fields[k]
+		if ad.access&accessWriteOnly != 0 {
+			continue
+		}
+		if k == o.unknownTarget && o.unknownPolicy == goskema.UnknownPassthrough {
+			extra, _ := m[k].(map[string]any)
+			for ek, ev := range extra {
+				if _, known := o.fields[ek]; !known {
+					out[ek] = ev
+				}
+			}
+			continue
+		}
+		v, ok := m[k]
+		if !ok {
+			if ad.applyDefault == nil || ad.computedDefault || preserve {
+				continue
+			}
+			dv, err := ad.applyDefault(ctx)
+			if err != nil {
+				iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+				continue
+			}
+			v = dv
+		}
+		if isNilValue(v) {
+			// null only where the field accepts it; otherwise the field is left out
+			if _, err := ad.parse(ctx, nil); err == nil {
+				out[k] = nil
+			}
+			continue
+		}
+		wv, err := ad.encodeValue(ctx, v)
+		if err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+			continue
+		}
+		out[k] = wv
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return out, nil
+}
+
+// EncodeWire implements goskema.WireEncoder by projecting the struct into the object's map shape.
+func (s *typedObjectSchema[T]) EncodeWire(ctx context.Context, v T) (any, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	return s.inner.EncodeWire(ctx, s.plan.toMap(rv))
+}
+
+// EncodeWire implements goskema.WireEncoder. Elements are encoded only when the element schema
+// has an encode direction; otherwise v is returned as-is.
+func (a *ArraySchema[E]) EncodeWire(ctx context.Context, v []E) (any, error) {
+	if _, ok := any(a.elem).(goskema.WireEncoder[E]); !ok || v == nil {
+		return v, nil
+	}
+	out := make([]any, len(v))
+	var iss goskema.Issues
+	for i, ev := range v {
+		w, err := encodeWith(ctx, a.elem, ev)
+		if err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+strconv.Itoa(i), issuesFromErr("/", err))...)
+			continue
+		}
+		out[i] = w
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return out, nil
+}
+
+// EncodeWire implements goskema.WireEncoder (see ArraySchema.EncodeWire).
+func (m mapSchema[V]) EncodeWire(ctx context.Context, v map[string]V) (any, error) {
+	return encodeMapValues(ctx, m.val, v)
+}
+
+// EncodeWire implements goskema.WireEncoder (see ArraySchema.EncodeWire).
+func (m *mapKVSchema[K, V]) EncodeWire(ctx context.Context, v map[K]V) (any, error) {
+	if m.val == nil {
+		return v, nil
+	}
+	return encodeMapValues(ctx, m.val, v)
+}
+
+func encodeMapValues[K ~string, V any](ctx context.Context, val goskema.Schema[V], v map[K]V) (any, error) {
+	if _, ok := any(val).(goskema.WireEncoder[V]); !ok || v == nil {
+		return v, nil
+	}
+	out := make(map[string]any, len(v))
+	var iss goskema.Issues
+	for k, ev := range v {
+		w, err := encodeWith(ctx, val, ev)
+		if err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+string(k), issuesFromErr("/", err))...)
+			continue
+		}
+		out[string(k)] = w
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return out, nil
+}
+
+// EncodeWire implements goskema.WireEncoder via the resolved target.
+func (l *lazySchema[T]) EncodeWire(ctx context.Context, v T) (any, error) {
+	return l.resolve().encodeValue(ctx, v)
+}
+
+// EncodeWire implements goskema.WireEncoder: Codec.Encode, then the In schema's own encoding.
+// Pointers to scalars (codec.Nullable/Optional) are dereferenced.
+func (s codecSchema[A, B]) EncodeWire(ctx context.Context, v B) (any, error) {
+	a, err := s.c.Encode(ctx, v)
+	if err != nil {
+		return nil, codecIssues(err)
+	}
+	if we, ok := any(s.c.In()).(goskema.WireEncoder[A]); ok {
+		return we.EncodeWire(ctx, a)
+	}
+	return scalarWire(a), nil
+}
+
+// scalarWire dereferences pointers to strings, booleans and numbers; nil pointers become nil.
+func scalarWire(v any) any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || kindFamily(rv.Type().Elem().Kind()) == 0 {
+		return v
+	}
+	if rv.IsNil() {
+		return nil
+	}
+	return rv.Elem().Interface()
+}
@@ -0,0 +1,166 @@
+package dsl_test
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func deploymentSchema() goskema.Schema[map[string]any] {
+	spec := g.Object().
+		Field("image", g.StringOf[string]()).Required().
+		Field("replicas", g.IntOf[int]()).Default(1).
+		Field("pullPolicy", g.StringOf[string]().Nullable()).Default("IfNotPresent").
+		MustBuild()
+	container := g.Object().
+		Field("name", g.StringOf[string]()).Required().
+		Field("port", g.IntOf[int]()).Default(80).
+		Field("token", g.StringOf[string]()).WriteOnly().
+		MustBuild()
+	return g.Object().
+		Field("name", g.StringOf[string]()).Required().
+		Field("spec", g.SchemaOf[map[string]any](spec)).Required().
+		Field("containers", g.ArrayOf[map[string]any](container)).
+		Field("sidecars", g.MapOf[map[string]any](container)).
+		MustBuild()
+}
+
+func TestParseWithMeta_NestedPresence(t *testing.T) {
+	ctx := context.Background()
+	dm, err := goskema.ParseFromWithMeta(ctx, deploymentSchema(), goskema.JSONBytes([]byte(
+		`{"name":"web","spec":{"image":"nginx","pullPolicy":null},"containers":[{"name":"a"},{"name":"b","port":8080}],"sidecars":{"log":{"name":"l"}}}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	want := map[string]goskema.Presence{
+		"/spec/image":        goskema.PresenceSeen,
+		"/spec/replicas":     goskema.PresenceDefaultApplied,
+		"/spec/pullPolicy":   goskema.PresenceSeen | goskema.PresenceWasNull,
+		"/containers/0/port": goskema.PresenceDefaultApplied,
+		"/containers/1/port": goskema.PresenceSeen,
+		"/sidecars/log":      goskema.PresenceSeen,
+		"/sidecars/log/port": goskema.PresenceDefaultApplied,
+		"/containers/0/name": goskema.PresenceSeen,
+		"/sidecars/log/name": goskema.PresenceSeen,
+	}
+	for p, bits := range want {
+		if got := dm.Presence[p]; got != bits {
+			t.Errorf("presence %s = %b, want %b", p, got, bits)
+		}
+	}
+}
+
+func TestEncodePreservingObject_Nested(t *testing.T) {
+	ctx := context.Background()
+	dm, err := goskema.ParseFromWithMeta(ctx, deploymentSchema(), goskema.JSONBytes([]byte(
+		`{"name":"web","spec":{"image":"nginx","pullPolicy":null},"containers":[{"name":"a","token":"t"},{"name":"b","port":8080}],"sidecars":{"log":{"name":"l"}}}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if dm.Value["spec"].(map[string]any)["replicas"] != 1 {
+		t.Fatalf("expected nested default to be materialized: %#v", dm.Value["spec"])
+	}
+
+	out := goskema.EncodePreservingObject(dm)
+	want := map[string]any{
+		"name":       "web",
+		"spec":       map[string]any{"image": "nginx", "pullPolicy": nil},
+		"containers": []map[string]any{{"name": "a"}, {"name": "b", "port": 8080}},
+		"sidecars":   map[string]map[string]any{"log": {"name": "l"}},
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Fatalf("got  %#v\nwant %#v", out, want)
+	}
+	// the decoded value is left untouched
+	if _, ok := dm.Value["spec"].(map[string]any)["replicas"]; !ok {
+		t.Fatalf("EncodePreservingObject must not modify its input")
+	}
+}
+
+func TestEncodePreservingArray_Elements(t *testing.T) {
+	ctx := context.Background()
+	item := g.Object().
+		Field("sku", g.StringOf[string]()).Required().
+		Field("qty", g.IntOf[int]()).Default(1).
+		MustBuild()
+	arr := g.Array[map[string]any](item)
+
+	dm, err := goskema.ParseFromWithMeta(ctx, arr, goskema.JSONBytes([]byte(`[{"sku":"a"},{"sku":"b","qty":3}]`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if p := dm.Presence["/0/qty"]; p != goskema.PresenceDefaultApplied {
+		t.Fatalf("/0/qty presence = %b", p)
+	}
+	elems := make([]any, len(dm.Value))
+	for i, e := range dm.Value {
+		elems[i] = e
+	}
+	out := goskema.EncodePreservingArray(goskema.Decoded[[]any]{Value: elems, Presence: dm.Presence})
+	want := []any{map[string]any{"sku": "a"}, map[string]any{"sku": "b", "qty": 3}}
+	if !reflect.DeepEqual(out, want) {
+		t.Fatalf("got  %#v\nwant %#v", out, want)
+	}
+}
+
+type deploySpec struct {
+	Image    string `json:"image"`
+	Replicas int    `json:"replicas"`
+}
+
+type deployment struct {
+	Name  string     `json:"name"`
+	Spec  deploySpec `json:"spec"`
+	Note  string     `json:"note"`
+	Owner string     `json:"owner"`
+}
+
+func TestEncodePreservingToMap_Typed(t *testing.T) {
+	ctx := context.Background()
+	spec := g.ObjectOf[deploySpec]().
+		Field("image", g.StringOf[string]()).Required().
+		Field("replicas", g.IntOf[int]()).Default(3).
+		MustBind()
+	s := g.ObjectOf[deployment]().
+		Field("name", g.StringOf[string]()).Required().
+		Field("spec", g.SchemaOf[deploySpec](spec)).Required().
+		Field("note", g.StringOf[string]()).
+		Field("owner", g.StringOf[string]()).Default("system").
+		MustBind()
+
+	dm, err := goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"name":"web","spec":{"image":"nginx"},"note":""}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if dm.Value.Spec.Replicas != 3 || dm.Value.Owner != "system" {
+		t.Fatalf("defaults not applied: %+v", dm.Value)
+	}
+	if p := dm.Presence["/spec/replicas"]; p != goskema.PresenceDefaultApplied {
+		t.Fatalf("/spec/replicas presence = %b", p)
+	}
+
+	// defaults stay out at every depth, an explicit zero value stays in
+	out, err := goskema.EncodePreservingToMap(ctx, s, dm)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	want := map[string]any{"name": "web", "spec": map[string]any{"image": "nginx"}, "note": ""}
+	if !reflect.DeepEqual(out, want) {
+		t.Fatalf("got  %#v\nwant %#v", out, want)
+	}
+
+	// values set after parsing are written; JSON keys follow the schema order
+	dm.Value.Spec.Image = "nginx:1.27"
+	dm.Value.Name = "api"
+	var buf bytes.Buffer
+	if err := goskema.EncodePreservingTo(ctx, s, dm, &buf); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if got, want := buf.String(), `{"name":"api","note":"","spec":{"image":"nginx:1.27"}}`; got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+}
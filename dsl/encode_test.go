@@ -0,0 +1,159 @@
+package dsl_test
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/codec"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type encEvent struct {
+	ID       string     `json:"id"`
+	At       time.Time  `json:"at"`
+	Ends     *time.Time `json:"ends"`
+	Tags     []string   `json:"tags"`
+	Status   string     `json:"status"`
+	Password string     `json:"password"`
+}
+
+func encEventSchema(t *testing.T) goskema.Schema[encEvent] {
+	t.Helper()
+	at := g.SchemaOf[time.Time](g.Codec[string, time.Time](codec.TimeRFC3339()))
+	s, err := g.ObjectOf[encEvent]().
+		Field("id", g.StringOf[string]()).Required().
+		Field("at", at).Required().
+		Field("ends", g.SchemaOf[*time.Time](g.Codec[*string, *time.Time](codec.Nullable(codec.TimeRFC3339())))).
+		Field("tags", g.ArrayOf[string](g.String())).
+		Field("status", g.StringOf[string]()).Default("open").
+		Field("password", g.StringOf[string]()).WriteOnly().
+		Bind()
+	if err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	return s
+}
+
+func TestEncodeToMap_TypedObject(t *testing.T) {
+	ctx := context.Background()
+	s := encEventSchema(t)
+	jst := time.FixedZone("JST", 9*3600)
+	ev := encEvent{ID: "e1", At: time.Date(2024, 6, 1, 9, 0, 0, 0, jst), Tags: []string{"a"}, Password: "secret"}
+
+	m, err := goskema.EncodeToMap(ctx, s, ev)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	want := map[string]any{
+		"id":     "e1",
+		"at":     "2024-06-01T00:00:00Z", // codec reverse: RFC3339 in UTC
+		"ends":   nil,                    // nullable field: nil pointer is null
+		"tags":   []string{"a"},
+		"status": "", // zero values of non-pointer fields are present
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("got %#v\nwant %#v", m, want)
+	}
+
+	end := ev.At.Add(time.Hour)
+	ev.Ends = &end
+	m, err = goskema.EncodeToMap(ctx, s, ev)
+	if err != nil || m["ends"] != "2024-06-01T01:00:00Z" {
+		t.Fatalf("ends: %#v %v", m["ends"], err)
+	}
+}
+
+func TestEncodeTo_SchemaOrderAndRoundtrip(t *testing.T) {
+	ctx := context.Background()
+	s := encEventSchema(t)
+	ev := encEvent{ID: "e1", At: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Status: "closed"}
+
+	var buf bytes.Buffer
+	if err := goskema.EncodeTo(ctx, s, ev, &buf); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if got, want := buf.String(), `{"at":"2024-06-01T00:00:00Z","ends":null,"id":"e1","status":"closed"}`; got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+
+	back, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("reparse: %v", err)
+	}
+	if !back.At.Equal(ev.At) || back.Status != "closed" {
+		t.Fatalf("roundtrip mismatch: %+v", back)
+	}
+}
+
+func TestEncodeToMap_DefaultsAndNested(t *testing.T) {
+	ctx := context.Background()
+	item := g.Object().
+		Field("sku", g.StringOf[string]()).Required().
+		Field("at", g.SchemaOf[time.Time](g.Codec[int64, time.Time](codec.UnixSeconds()))).
+		MustBuild()
+	obj := g.Object().
+		Field("items", g.ArrayOf[map[string]any](item)).Required().
+		Field("currency", g.StringOf[string]()).Default("JPY").
+		Field("id", g.StringOf[string]()).DefaultFunc(func(ctx context.Context) (any, error) { return "generated", nil }).
+		MustBuild()
+
+	m, err := goskema.EncodeToMap(ctx, obj, map[string]any{
+		"items": []map[string]any{{"sku": "a", "at": time.Unix(1717200000, 0)}},
+	})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	// static defaults are materialized, computed ones are left to the reader
+	want := map[string]any{
+		"currency": "JPY",
+		"items":    []any{map[string]any{"sku": "a", "at": int64(1717200000)}},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("got %#v\nwant %#v", m, want)
+	}
+}
+
+func TestEncodeToMap_ValidatesFirst(t *testing.T) {
+	ctx := context.Background()
+	s := encEventSchema(t)
+	_, err := goskema.EncodeToMap(ctx, s, encEvent{ID: "e1", Tags: []string{}})
+	if err != nil {
+		t.Fatalf("zero time is a value: %v", err)
+	}
+	obj := g.Object().Field("name", g.SchemaOf[string](g.String().MinLen(2))).Required().MustBuild()
+	_, err = goskema.EncodeToMap(ctx, obj, map[string]any{"name": "x"})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || iss[0].Code != goskema.CodeTooShort {
+		t.Fatalf("want too_short, got %v", err)
+	}
+	_, err = goskema.EncodeToMap(ctx, obj, map[string]any{})
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Code != goskema.CodeRequired {
+		t.Fatalf("want required, got %v", err)
+	}
+}
+
+func TestEncodeTo_FromStruct(t *testing.T) {
+	type span struct {
+		From time.Time  `json:"from"`
+		To   *time.Time `json:"to"`
+	}
+	type booking struct {
+		Name  string `json:"name" goskema:"required"`
+		Spans []span `json:"spans"`
+	}
+	ctx := context.Background()
+	s := g.FromStruct[booking]().MustBind()
+	from := time.Date(2024, 6, 1, 0, 0, 0, 500, time.UTC)
+	m, err := goskema.EncodeToMap(ctx, s, booking{Name: "b", Spans: []span{{From: from}}})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	want := map[string]any{"name": "b", "spans": []any{map[string]any{"from": "2024-06-01T00:00:00.0000005Z", "to": nil}}}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("got %#v\nwant %#v", m, want)
+	}
+}
@@ -0,0 +1,186 @@
+package dsl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// EnumBuilder exposes chaining options for enum schemas while implementing Schema[T].
+type EnumBuilder[T ~string | ~int] interface {
+	goskema.Schema[T]
+	// CaseInsensitive accepts string input regardless of case and yields the declared constant.
+	// Domain values passed to ValidateValue must still be one of the declared constants.
+	CaseInsensitive() EnumBuilder[T]
+}
+
+// Enum returns a schema that accepts only the given constants of a Go string/int enum type.
+// Values outside the set are reported as invalid_enum with the allowed values in the Hint;
+// JSON Schema exports `enum`.
+func Enum[T ~string | ~int](values ...T) EnumBuilder[T] {
+	return &enumSchema[T]{values: append([]T(nil), values...)}
+}
+
+// EnumOf adapts Enum(values...) to an AnyAdapter for use in object builders.
+// Example: Field("status", EnumOf(StatusActive, StatusSuspended)).Required()
+func EnumOf[T ~string | ~int](values ...T) AnyAdapter { return EnumOfSchema[T](Enum(values...)) }
+
+// EnumOfSchema converts a configured EnumBuilder into an AnyAdapter.
+// Example: Field("status", EnumOfSchema[Status](Enum(StatusActive).CaseInsensitive()))
+func EnumOfSchema[T ~string | ~int](eb EnumBuilder[T]) AnyAdapter { return anyAdapterFromSchema[T](eb) }
+
+type enumSchema[T ~string | ~int] struct {
+	values          []T
+	caseInsensitive bool
+}
+
+// CaseInsensitive enables case-insensitive matching of string input.
+func (s *enumSchema[T]) CaseInsensitive() EnumBuilder[T] { s.caseInsensitive = true; return s }
+
+func (s *enumSchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	var zero T
+	tv, ok := s.coerce(v)
+	if !ok {
+		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected " + s.jsonType()}}
+	}
+	if canon, ok := s.match(tv); ok {
+		return canon, nil
+	}
+	return zero, s.notAllowed(tv)
+}
+
+func (s *enumSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	tv, err := s.Parse(ctx, v)
+	return goskema.Decoded[T]{Value: tv, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+
+// ---- streaming SPI ----
+func (s *enumSchema[T]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (T, error) {
+	raw, err := nextScalar(src, s.jsonType())
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return s.Parse(ctx, raw)
+}
+
+func (s *enumSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[T], error) {
+	v, err := s.ParseFromSource(ctx, src, opt)
+	return goskema.Decoded[T]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+
+func (s *enumSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	if _, ok := s.coerce(v); !ok {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected " + s.jsonType()}}
+	}
+	return nil
+}
+
+func (s *enumSchema[T]) RuleCheck(ctx context.Context, v any) error {
+	tv, ok := s.coerce(v)
+	if !ok {
+		return nil
+	}
+	if _, ok := s.match(tv); !ok {
+		return s.notAllowed(tv)
+	}
+	return nil
+}
+
+func (s *enumSchema[T]) Validate(ctx context.Context, v any) error {
+	if err := s.TypeCheck(ctx, v); err != nil {
+		return err
+	}
+	return s.RuleCheck(ctx, v)
+}
+
+func (s *enumSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	for _, e := range s.values {
+		if e == v {
+			return nil
+		}
+	}
+	return s.notAllowed(v)
+}
+
+func (s *enumSchema[T]) JSONSchema() (*js.Schema, error) {
+	out := &js.Schema{Type: s.jsonType(), Enum: make([]any, 0, len(s.values))}
+	for _, e := range s.values {
+		out.Enum = append(out.Enum, e)
+	}
+	return out, nil
+}
+
+func (s *enumSchema[T]) isString() bool {
+	var zero T
+	return reflect.TypeOf(zero).Kind() == reflect.String
+}
+
+func (s *enumSchema[T]) jsonType() string {
+	if s.isString() {
+		return "string"
+	}
+	return "integer"
+}
+
+// match returns the declared constant equal to v (case-folded for strings when enabled).
+func (s *enumSchema[T]) match(v T) (T, bool) {
+	for _, e := range s.values {
+		if e == v {
+			return e, true
+		}
+	}
+	if s.caseInsensitive && s.isString() {
+		got := reflect.ValueOf(v).String()
+		for _, e := range s.values {
+			if strings.EqualFold(reflect.ValueOf(e).String(), got) {
+				return e, true
+			}
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func (s *enumSchema[T]) notAllowed(v T) goskema.Issues {
+	names := make([]string, 0, len(s.values))
+	for _, e := range s.values {
+		names = append(names, fmt.Sprint(e))
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidEnum, Message: i18n.T(goskema.CodeInvalidEnum, nil), Hint: "allowed: " + strings.Join(names, ", "), Params: map[string]any{"allowed": append([]T(nil), s.values...), "got": v}}}
+}
+
+// coerce converts wire input (string, or json.Number/float64/Go ints for integer enums) into T.
+func (s *enumSchema[T]) coerce(v any) (T, bool) {
+	var zero T
+	if tv, ok := v.(T); ok {
+		return tv, true
+	}
+	if v == nil {
+		return zero, false
+	}
+	rt := reflect.TypeOf(zero)
+	if s.isString() {
+		rv := reflect.ValueOf(v)
+		if _, isNum := v.(json.Number); isNum || rv.Kind() != reflect.String {
+			return zero, false
+		}
+		return rv.Convert(rt).Interface().(T), true
+	}
+	r, ok := ratOf(v)
+	if !ok || !r.IsInt() || !r.Num().IsInt64() {
+		return zero, false
+	}
+	out := reflect.New(rt).Elem()
+	if out.OverflowInt(r.Num().Int64()) {
+		return zero, false
+	}
+	out.SetInt(r.Num().Int64())
+	return out.Interface().(T), true
+}
@@ -0,0 +1,88 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type enumStatus string
+
+const (
+	statusActive    enumStatus = "active"
+	statusSuspended enumStatus = "suspended"
+)
+
+type enumLevel int
+
+func TestEnum_StringAndInt(t *testing.T) {
+	ctx := context.Background()
+	s := g.Enum(statusActive, statusSuspended)
+	if v, err := s.Parse(ctx, "active"); err != nil || v != statusActive {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	_, err := s.Parse(ctx, "deleted")
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Code != goskema.CodeInvalidEnum || iss[0].Hint != "allowed: active, suspended" || iss[0].Params["got"] != enumStatus("deleted") {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+	if code := firstCode(t, s.ValidateValue(ctx, "ACTIVE")); code != goskema.CodeInvalidEnum {
+		t.Fatalf("want invalid_enum, got %s", code)
+	}
+
+	ci := g.Enum(statusActive, statusSuspended).CaseInsensitive()
+	if v, err := ci.Parse(ctx, "Suspended"); err != nil || v != statusSuspended {
+		t.Fatalf("case-insensitive match should yield the constant: %v %v", v, err)
+	}
+
+	lv := g.Enum[enumLevel](1, 2, 3)
+	if v, err := lv.Parse(ctx, json.Number("2")); err != nil || v != 2 {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	if code := firstCode(t, lv.Validate(ctx, json.Number("4"))); code != goskema.CodeInvalidEnum {
+		t.Fatalf("want invalid_enum, got %s", code)
+	}
+	if code := firstCode(t, lv.Validate(ctx, "2")); code != goskema.CodeInvalidType {
+		t.Fatalf("want invalid_type, got %s", code)
+	}
+}
+
+func TestEnum_BindAndStreaming(t *testing.T) {
+	ctx := context.Background()
+	type Account struct {
+		Status enumStatus `json:"status"`
+	}
+	acct := g.ObjectOf[Account]().
+		Field("status", g.EnumOf(statusActive, statusSuspended)).Required().
+		UnknownStrict().
+		MustBind()
+
+	v, err := goskema.ParseFrom(ctx, acct, goskema.JSONBytes([]byte(`{"status":"suspended"}`)))
+	if err != nil || v.Status != statusSuspended {
+		t.Fatalf("unexpected: %+v %v", v, err)
+	}
+	_, err = goskema.ParseFrom(ctx, acct, goskema.JSONBytes([]byte(`{"status":"gone"}`)))
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/status" || iss[0].Code != goskema.CodeInvalidEnum {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+	if err := acct.ValidateValue(ctx, Account{Status: "gone"}); err == nil {
+		t.Fatalf("ValidateValue should reject an out-of-set constant")
+	}
+}
+
+func TestEnum_JSONSchema(t *testing.T) {
+	s, err := g.Enum(statusActive, statusSuspended).JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema err: %v", err)
+	}
+	got := normalize(s)
+	want := normalize(map[string]any{"type": "string", "enum": []any{"active", "suspended"}})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+}
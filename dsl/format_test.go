@@ -0,0 +1,69 @@
+package dsl_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestFormat_TreeAndStreaming(t *testing.T) {
+	ctx := context.Background()
+	obj := g.Object().
+		Field("email", g.StringOfSchema[string](g.String().Format("email"))).Required().
+		Field("tags", g.ArrayOf[string](g.String().Format("hostname"))).
+		UnknownStrict().
+		MustBuild()
+
+	if _, err := obj.Parse(ctx, map[string]any{"email": "a@example.com"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := obj.Parse(ctx, map[string]any{"email": "not-an-email"})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/email" || iss[0].Code != goskema.CodeInvalidFormat || iss[0].Params["format"] != "email" {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	_, err = goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"email":"x"}`)))
+	iss, _ = goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/email" || iss[0].Code != goskema.CodeInvalidFormat {
+		t.Fatalf("unexpected streaming issues: %+v", iss)
+	}
+}
+
+func TestFormat_PerSchemaRegistry(t *testing.T) {
+	ctx := context.Background()
+	reg := goskema.NewFormatRegistry().Register("sku", func(s string) error {
+		if len(s) != 6 {
+			return errors.New("sku must be 6 chars")
+		}
+		return nil
+	})
+	s := g.String().Formats(reg).Format("sku")
+	if err := s.ValidateValue(ctx, "ABC123"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if code := firstCode(t, s.ValidateValue(ctx, "ABC")); code != goskema.CodeInvalidFormat {
+		t.Fatalf("want invalid_format, got %s", code)
+	}
+	js, _ := s.JSONSchema()
+	if js.Format != "sku" {
+		t.Fatalf("format not exported: %q", js.Format)
+	}
+}
+
+func TestFormat_UnknownFailsAtBuild(t *testing.T) {
+	_, err := g.Object().
+		Field("id", g.StringOfSchema[string](g.String().Format("no-such-format"))).
+		Build()
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/id" || iss[0].Code != goskema.CodeInvalidFormat {
+		t.Fatalf("unexpected build result: %+v", iss)
+	}
+	// Used standalone, the schema fails closed at validation time.
+	if code := firstCode(t, g.String().Format("no-such-format").ValidateValue(context.Background(), "x")); code != goskema.CodeInvalidFormat {
+		t.Fatalf("want invalid_format, got %s", code)
+	}
+}
@@ -0,0 +1,723 @@
+package dsl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/codec"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// FromStruct derives an ObjectOf[T] builder from T's struct tags, so the field list lives only in
+// the Go type. Keys follow goskema.ResolveStructKey (goskema:"name=..." > json > field name).
+//
+// The goskema tag vocabulary (comma separated):
+//
+//	required        the key must be present
+//	min=N, max=N    string length (runes), numeric range, or slice/map size
+//	pattern=RE      string regexp (RE2; may contain commas when last)
+//	enum=a|b        allowed string values
+//	default=x       default for a missing key (string, bool and number fields)
+//	format=email    named string format (see goskema.RegisterFormat)
+//
+// Nested structs, slices, maps, pointers and goskema.Optional (both nullable) and time.Time
+// (RFC 3339 strings) are derived recursively; embedded structs are flattened like encoding/json.
+// Self-referential types become Lazy references. The result can be extended before binding:
+//
+//	user := FromStruct[User]().RefineT("rule", fn).MustBind()
+//
+// Unsupported field types and malformed tags are reported by Bind.
+func FromStruct[T any]() *objectBuilderT[T] {
+	tb := ObjectOf[T]()
+	rt := reflect.TypeOf((*T)(nil)).Elem()
+	if rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct {
+		tb.inner.buildIssues = goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "FromStruct[T] requires struct T"}}
+		return tb
+	}
+	d := &structDeriver{done: map[reflect.Type]*AnyAdapter{}, active: map[reflect.Type]bool{}}
+	d.fillObject(tb.inner, rt, "")
+	tb.inner.buildIssues = append(tb.inner.buildIssues, d.iss...)
+	return tb
+}
+
+// structDeriver builds adapters for Go types; done/active resolve recursive struct types.
+type structDeriver struct {
+	done   map[reflect.Type]*AnyAdapter
+	active map[reflect.Type]bool
+	iss    goskema.Issues
+}
+
+func (d *structDeriver) fail(path, hint string) {
+	if path == "" {
+		path = "/"
+	}
+	d.iss = goskema.AppendIssues(d.iss, goskema.Issue{Path: path, Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: hint})
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// fillObject registers the fields of struct type rt on b. Embedded structs are flattened after
+// the outer fields, which win on name clashes (as in encoding/json).
+func (d *structDeriver) fillObject(b *objectBuilder, rt reflect.Type, base string) {
+	d.fillFields(b, rt, base, false)
+}
+
+func (d *structDeriver) fillFields(b *objectBuilder, rt reflect.Type, base string, promoted bool) {
+	var embedded []reflect.StructField
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if isPromotedStruct(sf) {
+			embedded = append(embedded, sf)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		key := goskema.ResolveStructKey(sf)
+		if key == "-" || key == "" {
+			continue
+		}
+		if _, exists := b.fields[key]; promoted && exists {
+			continue
+		}
+		path := base + "/" + key
+		opts, err := parseStructTag(sf.Tag.Get("goskema"))
+		if err != nil {
+			d.fail(path, err.Error())
+			continue
+		}
+		ad, ok := d.adapterFor(sf.Type, opts, path)
+		if !ok {
+			continue
+		}
+		b.Field(key, ad)
+		if opts.required {
+			b.Require(key)
+		}
+	}
+	for _, sf := range embedded {
+		d.fillFields(b, sf.Type, base, true)
+	}
+}
+
+// adapterFor returns an adapter whose parsed value has exactly type ft.
+func (d *structDeriver) adapterFor(ft reflect.Type, opts structTagOpts, path string) (AnyAdapter, bool) {
+	switch {
+	case ft == timeType:
+		if opts.hasConstraints() {
+			d.fail(path, "min/max/pattern/enum/format are not supported for time.Time")
+			return AnyAdapter{}, false
+		}
+		return d.withDefault(anyAdapterFromSchema[time.Time](Codec(codec.TimeRFC3339())), opts, path)
+	case ft.Kind() == reflect.Struct && ft.Implements(optionalFieldType):
+		// Optional[X]: X's adapter accepting null; Bind projects the presence state
+		et := reflect.Zero(ft).Interface().(goskema.OptionalField).OptionalElemType()
+		elem, ok := d.adapterFor(et, opts, path)
+		if !ok {
+			return AnyAdapter{}, false
+		}
+		return Nullable(elem), true
+	case ft.Kind() == reflect.Pointer:
+		elem, ok := d.adapterFor(ft.Elem(), opts, path)
+		if !ok {
+			return AnyAdapter{}, false
+		}
+		return pointerAdapter(elem, ft), true
+	case ft.Kind() == reflect.Struct:
+		if opts.hasConstraints() || opts.def != nil {
+			d.fail(path, "tag constraints are not supported for struct fields")
+			return AnyAdapter{}, false
+		}
+		return d.structAdapter(ft, path)
+	case ft.Kind() == reflect.Slice:
+		return d.collectionAdapter(ft, opts, path)
+	case ft.Kind() == reflect.Map:
+		if ft.Key().Kind() != reflect.String {
+			d.fail(path, "map keys must be strings: "+ft.String())
+			return AnyAdapter{}, false
+		}
+		return d.collectionAdapter(ft, opts, path)
+	case ft.Kind() == reflect.Interface:
+		return AnyAdapter{
+			parse:         func(ctx context.Context, v any) (any, error) { return v, nil },
+			validateValue: func(ctx context.Context, v any) error { return nil },
+			jsonSchema:    func(*jsonExport) (*js.Schema, error) { return &js.Schema{}, nil },
+			out:           ft,
+		}, true
+	}
+	base, ok := d.scalarAdapter(ft, opts, path)
+	if !ok {
+		return AnyAdapter{}, false
+	}
+	return d.withDefault(convertAdapter(base, ft), opts, path)
+}
+
+// scalarAdapter builds the constrained string/bool/number adapter for a scalar kind.
+func (d *structDeriver) scalarAdapter(ft reflect.Type, opts structTagOpts, path string) (AnyAdapter, bool) {
+	k := ft.Kind()
+	if k != reflect.String && (opts.pattern != "" || len(opts.enum) > 0 || opts.format != "") {
+		d.fail(path, "pattern/enum/format require a string field")
+		return AnyAdapter{}, false
+	}
+	switch k {
+	case reflect.String:
+		sb := String()
+		if opts.min != "" || opts.max != "" {
+			lo, hi, err := opts.lengths()
+			if err != nil {
+				d.fail(path, err.Error())
+				return AnyAdapter{}, false
+			}
+			if lo >= 0 {
+				sb = sb.MinLen(lo)
+			}
+			if hi >= 0 {
+				sb = sb.MaxLen(hi)
+			}
+		}
+		if opts.pattern != "" {
+			re, err := regexp.Compile(opts.pattern)
+			if err != nil {
+				d.fail(path, "invalid pattern: "+err.Error())
+				return AnyAdapter{}, false
+			}
+			sb = sb.Pattern(re)
+		}
+		if opts.format != "" {
+			sb = sb.Format(opts.format)
+		}
+		if len(opts.enum) > 0 {
+			sb = sb.OneOf(opts.enum...)
+		}
+		return StringOfSchema[string](sb), true
+	case reflect.Bool:
+		if opts.min != "" || opts.max != "" {
+			d.fail(path, "min/max are not supported for bool fields")
+			return AnyAdapter{}, false
+		}
+		return BoolOf[bool](), true
+	}
+	for _, b := range []string{opts.min, opts.max} {
+		if _, ok := new(big.Rat).SetString(b); b != "" && !ok {
+			d.fail(path, "min/max must be numbers: "+b)
+			return AnyAdapter{}, false
+		}
+	}
+	nb := NumberJSON()
+	if opts.min != "" {
+		nb = nb.Min(json.Number(opts.min))
+	}
+	if opts.max != "" {
+		nb = nb.Max(json.Number(opts.max))
+	}
+	switch k {
+	case reflect.Int:
+		return IntOfSchema[int](nb), true
+	case reflect.Int64:
+		return Int64OfSchema[int64](nb), true
+	case reflect.Int32:
+		return Int32OfSchema[int32](nb), true
+	case reflect.Int16:
+		return Int16OfSchema[int16](nb), true
+	case reflect.Int8:
+		return Int8OfSchema[int8](nb), true
+	case reflect.Uint, reflect.Uint64:
+		return UintOfSchema[uint64](nb), true
+	case reflect.Uint32:
+		return Uint32OfSchema[uint32](nb), true
+	case reflect.Uint16:
+		return Uint16OfSchema[uint16](nb), true
+	case reflect.Uint8:
+		return Uint8OfSchema[uint8](nb), true
+	case reflect.Float64, reflect.Float32:
+		return FloatOfSchema[float64](nb), true
+	}
+	d.fail(path, "unsupported field type "+ft.String())
+	return AnyAdapter{}, false
+}
+
+// withDefault applies default=x by parsing the tag text through the adapter.
+func (d *structDeriver) withDefault(ad AnyAdapter, opts structTagOpts, path string) (AnyAdapter, bool) {
+	if opts.def == nil {
+		return ad, true
+	}
+	var wire any = *opts.def
+	switch ad.out.Kind() {
+	case reflect.Bool:
+		bv, err := strconv.ParseBool(*opts.def)
+		if err != nil {
+			d.fail(path, "invalid bool default: "+*opts.def)
+			return AnyAdapter{}, false
+		}
+		wire = bv
+	case reflect.String, reflect.Struct:
+	default:
+		wire = json.Number(*opts.def)
+	}
+	if _, err := ad.parse(context.Background(), wire); err != nil {
+		d.fail(path, "default does not satisfy the field schema: "+*opts.def)
+		return AnyAdapter{}, false
+	}
+	ad.applyDefault = func(ctx context.Context) (any, error) { return ad.parse(ctx, wire) }
+	prev := ad.jsonSchema
+	ad.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
+		s, err := prev(ex)
+		if err != nil {
+			return nil, err
+		}
+		s.Default = wire
+		return s, nil
+	}
+	return ad, true
+}
+
+// structAdapter derives a nested object for struct type ft and projects it into ft.
+func (d *structDeriver) structAdapter(ft reflect.Type, path string) (AnyAdapter, bool) {
+	if ad, ok := d.done[ft]; ok {
+		return *ad, true
+	}
+	if d.active[ft] {
+		// self-reference: resolve once the enclosing derivation has finished
+		name := ft.Name()
+		lz := LazyNamed(name, func() AnyAdapter { return *d.done[ft] })
+		lz.out = ft
+		return lz, true
+	}
+	d.active[ft] = true
+	defer delete(d.active, ft)
+	b := Object()
+	n := len(d.iss)
+	d.fillObject(b, ft, path)
+	if len(d.iss) > n {
+		return AnyAdapter{}, false
+	}
+	s, err := b.Build()
+	if err != nil {
+		d.iss = goskema.AppendIssues(d.iss, rebaseIssuesUnder(path, issuesFromErr("/", err))...)
+		return AnyAdapter{}, false
+	}
+	obj := s.(*objectSchema)
+	plan, iss := planStruct(ft, obj)
+	if len(iss) > 0 {
+		d.iss = goskema.AppendIssues(d.iss, rebaseIssuesUnder(path, iss)...)
+		return AnyAdapter{}, false
+	}
+	ad := AnyAdapter{
+		parse: func(ctx context.Context, v any) (any, error) {
+			if reflect.TypeOf(v) == ft {
+				return v, obj.ValidateValue(ctx, plan.toMap(reflect.ValueOf(v)))
+			}
+			m, err := obj.Parse(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+			rv, iss := plan.build(m)
+			if len(iss) > 0 {
+				return nil, iss
+			}
+			return rv.Interface(), nil
+		},
+		validateValue: func(ctx context.Context, v any) error {
+			rv := reflect.ValueOf(v)
+			if !rv.IsValid() || rv.Type() != ft {
+				return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "invalid field type"}}
+			}
+			return obj.ValidateValue(ctx, plan.toMap(rv))
+		},
+		encode: func(ctx context.Context, v any) (any, error) {
+			rv := reflect.ValueOf(v)
+			if rv.Type() != ft {
+				return v, nil
+			}
+			return obj.EncodeWire(ctx, plan.toMap(rv))
+		},
+		jsonSchema: obj.exportJSONSchema,
+		orig:       obj,
+		out:        ft,
+	}
+	d.done[ft] = &ad
+	return ad, true
+}
+
+// collectionAdapter derives []X and map[string]X adapters from Array and MapKV over the element
+// adapter (streaming, bounds and issue paths included); min/max bound the element count.
+func (d *structDeriver) collectionAdapter(ft reflect.Type, opts structTagOpts, path string) (AnyAdapter, bool) {
+	if opts.pattern != "" || len(opts.enum) > 0 || opts.format != "" || opts.def != nil {
+		d.fail(path, "only min/max are supported for slice and map fields")
+		return AnyAdapter{}, false
+	}
+	lo, hi, err := opts.lengths()
+	if err != nil {
+		d.fail(path, err.Error())
+		return AnyAdapter{}, false
+	}
+	elem, ok := d.adapterFor(ft.Elem(), structTagOpts{}, path+"/*")
+	if !ok {
+		return AnyAdapter{}, false
+	}
+	es := derivedElemSchema{ad: elem}
+	if ft.Kind() == reflect.Map {
+		m := MapKV[string, derivedElem](nil, es)
+		if lo >= 0 {
+			m.MinProps(lo)
+		}
+		if hi >= 0 {
+			m.MaxProps(hi)
+		}
+		return projectCollection(anyAdapterFromSchema[map[string]derivedElem](m), elem, ft), true
+	}
+	a := Array[derivedElem](es)
+	if lo >= 0 {
+		a.Min(lo)
+	}
+	if hi >= 0 {
+		a.Max(hi)
+	}
+	return projectCollection(anyAdapterFromSchema[[]derivedElem](a), elem, ft), true
+}
+
+// projectCollection converts between base's []derivedElem/map[string]derivedElem values and the
+// field type ft.
+func projectCollection(base, elem AnyAdapter, ft reflect.Type) AnyAdapter {
+	isMap := ft.Kind() == reflect.Map
+	toField := func(v any) any {
+		if isMap {
+			src := v.(map[string]derivedElem)
+			out := reflect.MakeMapWithSize(ft, len(src))
+			for k, ev := range src {
+				out.SetMapIndex(reflect.ValueOf(k).Convert(ft.Key()), valueOrZero(ev.v, ft.Elem()))
+			}
+			return out.Interface()
+		}
+		src := v.([]derivedElem)
+		out := reflect.MakeSlice(ft, len(src), len(src))
+		for i, ev := range src {
+			out.Index(i).Set(valueOrZero(ev.v, ft.Elem()))
+		}
+		return out.Interface()
+	}
+	toBase := func(rv reflect.Value) any {
+		if isMap {
+			out := make(map[string]derivedElem, rv.Len())
+			it := rv.MapRange()
+			for it.Next() {
+				out[it.Key().String()] = derivedElem{v: it.Value().Interface()}
+			}
+			return out
+		}
+		out := make([]derivedElem, rv.Len())
+		for i := range out {
+			out[i] = derivedElem{v: rv.Index(i).Interface()}
+		}
+		return out
+	}
+	validate := func(ctx context.Context, v any) error {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || rv.Type() != ft {
+			return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "invalid field type"}}
+		}
+		return base.validateValue(ctx, toBase(rv))
+	}
+	out := AnyAdapter{validateValue: validate, jsonSchema: base.jsonSchema, out: ft}
+	out.parse = func(ctx context.Context, v any) (any, error) {
+		if v != nil && reflect.TypeOf(v) == ft {
+			return v, validate(ctx, v)
+		}
+		pv, err := base.parse(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return toField(pv), nil
+	}
+	out.parseFromSource = func(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (any, error) {
+		pv, err := base.parseFromSource(ctx, src, opt)
+		if err != nil {
+			return nil, err
+		}
+		return toField(pv), nil
+	}
+	if elem.encode != nil {
+		out.encode = func(ctx context.Context, v any) (any, error) {
+			rv := reflect.ValueOf(v)
+			if rv.Type() != ft || rv.IsNil() {
+				return v, nil
+			}
+			return base.encode(ctx, toBase(rv))
+		}
+	}
+	return out
+}
+
+// derivedElem boxes an element value of a derived slice or map, so that Array and MapKV always
+// parse their []any/map[string]any input through the element adapter. parsed marks values that
+// the element adapter produced, which the container's validation pass does not check again.
+type derivedElem struct {
+	v      any
+	parsed bool
+}
+
+// derivedElemSchema exposes a derived element adapter as the element schema of Array and MapKV.
+type derivedElemSchema struct{ ad AnyAdapter }
+
+func (s derivedElemSchema) Parse(ctx context.Context, v any) (derivedElem, error) {
+	pv, err := s.ad.parse(ctx, v)
+	if err != nil {
+		return derivedElem{}, err
+	}
+	return derivedElem{v: pv, parsed: true}, nil
+}
+
+func (s derivedElemSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[derivedElem], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (derivedElem, error) { return s.Parse(ctx, v) })
+}
+
+// ParseFromSource streams through the element adapter when it supports it.
+func (s derivedElemSchema) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (derivedElem, error) {
+	if s.ad.parseFromSource == nil {
+		return derivedElem{}, goskema.ErrStreamingUnsupported
+	}
+	pv, err := s.ad.parseFromSource(ctx, src, opt)
+	if err != nil {
+		return derivedElem{}, err
+	}
+	return derivedElem{v: pv, parsed: true}, nil
+}
+
+func (s derivedElemSchema) TypeCheck(ctx context.Context, v any) error {
+	_, err := s.Parse(ctx, v)
+	return err
+}
+
+func (s derivedElemSchema) RuleCheck(ctx context.Context, v any) error { return nil }
+
+func (s derivedElemSchema) Validate(ctx context.Context, v any) error { return s.TypeCheck(ctx, v) }
+
+func (s derivedElemSchema) ValidateValue(ctx context.Context, v derivedElem) error {
+	if v.parsed {
+		return nil
+	}
+	return s.ad.validateValue(ctx, v.v)
+}
+
+// EncodeWire implements goskema.WireEncoder via the element adapter.
+func (s derivedElemSchema) EncodeWire(ctx context.Context, v derivedElem) (any, error) {
+	return s.ad.encodeValue(ctx, v.v)
+}
+
+func (s derivedElemSchema) JSONSchema() (*js.Schema, error) {
+	return s.exportJSONSchema(&jsonExport{})
+}
+
+func (s derivedElemSchema) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	return s.ad.jsonSchema(ex)
+}
+
+// convertAdapter projects a scalar adapter's output into the (possibly named) field type ft.
+func convertAdapter(base AnyAdapter, ft reflect.Type) AnyAdapter {
+	if base.out == ft {
+		return base
+	}
+	out := base
+	out.out = ft
+	conv := func(v any) any {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || rv.Type() == ft {
+			return v
+		}
+		return rv.Convert(ft).Interface()
+	}
+	out.parse = func(ctx context.Context, v any) (any, error) {
+		if v != nil && reflect.TypeOf(v) == ft {
+			v = reflect.ValueOf(v).Convert(base.out).Interface()
+		}
+		pv, err := base.parse(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return conv(pv), nil
+	}
+	if base.parseFromSource != nil {
+		out.parseFromSource = func(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (any, error) {
+			pv, err := base.parseFromSource(ctx, src, opt)
+			if err != nil {
+				return nil, err
+			}
+			return conv(pv), nil
+		}
+	}
+	out.validateValue = func(ctx context.Context, v any) error {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || !rv.Type().ConvertibleTo(base.out) {
+			return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: "invalid field type"}}
+		}
+		return base.validateValue(ctx, rv.Convert(base.out).Interface())
+	}
+	if base.encode != nil {
+		out.encode = func(ctx context.Context, v any) (any, error) {
+			if rv := reflect.ValueOf(v); rv.Type() == ft {
+				v = rv.Convert(base.out).Interface()
+			}
+			return base.encode(ctx, v)
+		}
+	}
+	return out
+}
+
+// pointerAdapter makes elem nullable and projects values into *X.
+func pointerAdapter(elem AnyAdapter, ft reflect.Type) AnyAdapter {
+	out := elem
+	out.out = ft
+	wrap := func(pv any) any {
+		p := reflect.New(ft.Elem())
+		p.Elem().Set(valueOrZero(pv, ft.Elem()))
+		return p.Interface()
+	}
+	out.parse = func(ctx context.Context, v any) (any, error) {
+		if v == nil {
+			return reflect.Zero(ft).Interface(), nil
+		}
+		if rv := reflect.ValueOf(v); rv.Type() == ft {
+			if rv.IsNil() {
+				return v, nil
+			}
+			v = rv.Elem().Interface()
+		}
+		pv, err := elem.parse(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return wrap(pv), nil
+	}
+	// null tokens cannot be peeked; fall back to decoding the value first
+	out.parseFromSource = nil
+	out.validateValue = func(ctx context.Context, v any) error {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+			return nil
+		}
+		if rv.Type() == ft {
+			rv = rv.Elem()
+		}
+		return elem.validateValue(ctx, rv.Interface())
+	}
+	if elem.applyDefault != nil {
+		out.applyDefault = func(ctx context.Context) (any, error) {
+			dv, err := elem.applyDefault(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return wrap(dv), nil
+		}
+	}
+	out.encode = func(ctx context.Context, v any) (any, error) {
+		rv := reflect.ValueOf(v)
+		if rv.Type() != ft {
+			return elem.encodeValue(ctx, v)
+		}
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return elem.encodeValue(ctx, rv.Elem().Interface())
+	}
+	return out
+}
+
+func valueOrZero(v any, t reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(v)
+}
+
+// structTagOpts holds the parsed goskema tag vocabulary (name= is handled by ResolveStructKey).
+type structTagOpts struct {
+	required bool
+	min, max string
+	pattern  string
+	enum     []string
+	def      *string
+	format   string
+}
+
+func (o structTagOpts) hasConstraints() bool {
+	return o.min != "" || o.max != "" || o.pattern != "" || len(o.enum) > 0 || o.format != ""
+}
+
+// lengths returns min/max as non-negative counts (-1 when unset).
+func (o structTagOpts) lengths() (int, int, error) {
+	lo, hi := -1, -1
+	var err error
+	if o.min != "" {
+		if lo, err = strconv.Atoi(o.min); err != nil || lo < 0 {
+			return 0, 0, errors.New("min must be a non-negative integer here: " + o.min)
+		}
+	}
+	if o.max != "" {
+		if hi, err = strconv.Atoi(o.max); err != nil || hi < 0 {
+			return 0, 0, errors.New("max must be a non-negative integer here: " + o.max)
+		}
+	}
+	return lo, hi, nil
+}
+
+var structTagKeys = []string{"name=", "required", "min=", "max=", "pattern=", "enum=", "default=", "format="}
+
+// parseStructTag parses the goskema tag. A part that does not start with a known option
+// continues the previous pattern=/default= value (so regexps may contain commas).
+func parseStructTag(tag string) (structTagOpts, error) {
+	var o structTagOpts
+	if tag == "" {
+		return o, nil
+	}
+	var parts []string
+	for _, p := range strings.Split(tag, ",") {
+		known := false
+		for _, k := range structTagKeys {
+			if p == k || strings.HasSuffix(k, "=") && strings.HasPrefix(p, k) {
+				known = true
+				break
+			}
+		}
+		if !known && len(parts) > 0 && (strings.HasPrefix(parts[len(parts)-1], "pattern=") || strings.HasPrefix(parts[len(parts)-1], "default=")) {
+			parts[len(parts)-1] += "," + p
+			continue
+		}
+		parts = append(parts, p)
+	}
+	for _, p := range parts {
+		key, val, _ := strings.Cut(p, "=")
+		switch key {
+		case "name":
+		case "required":
+			o.required = true
+		case "min":
+			o.min = val
+		case "max":
+			o.max = val
+		case "pattern":
+			o.pattern = val
+		case "enum":
+			o.enum = strings.Split(val, "|")
+		case "default":
+			v := val
+			o.def = &v
+		case "format":
+			o.format = val
+		default:
+			return o, errors.New("unknown goskema tag option: " + p)
+		}
+	}
+	return o, nil
+}
@@ -0,0 +1,303 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type fsRole string
+
+type fsAddress struct {
+	City string `json:"city" goskema:"required,min=1"`
+	Zip  string `json:"zip" goskema:"pattern=^[0-9]{3}-[0-9]{4}$"`
+}
+
+type fsMeta struct {
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type fsUser struct {
+	fsMeta
+	Name    string            `json:"name" goskema:"required,min=1,max=8"`
+	Email   string            `json:"email" goskema:"format=email"`
+	Role    fsRole            `json:"role" goskema:"enum=admin|member,default=member"`
+	Age     int64             `json:"age" goskema:"min=0,max=150"`
+	Active  bool              `json:"active" goskema:"default=true"`
+	Score   float32           `json:"score"`
+	Home    fsAddress         `json:"home"`
+	Work    *fsAddress        `json:"work"`
+	Tags    []string          `json:"tags" goskema:"max=2"`
+	Labels  map[string]string `json:"labels"`
+	Skipped string            `json:"-"`
+	secret  string
+}
+
+func TestFromStruct_ParseAndDefaults(t *testing.T) {
+	s := g.FromStruct[fsUser]().MustBind()
+	u, err := s.Parse(context.Background(), map[string]any{
+		"name":      "alice",
+		"email":     "a@example.com",
+		"age":       30,
+		"score":     1.5,
+		"createdAt": "2024-01-02T03:04:05Z",
+		"home":      map[string]any{"city": "Tokyo", "zip": "100-0001"},
+		"work":      map[string]any{"city": "Osaka"},
+		"tags":      []any{"x", "y"},
+		"labels":    map[string]any{"k": "v"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if u.Name != "alice" || u.Role != "member" || !u.Active || u.Age != 30 || u.Score != 1.5 {
+		t.Fatalf("unexpected scalars: %+v", u)
+	}
+	if u.CreatedAt.Year() != 2024 || u.Home.City != "Tokyo" || u.Work == nil || u.Work.City != "Osaka" {
+		t.Fatalf("unexpected nested values: %+v", u)
+	}
+	if len(u.Tags) != 2 || u.Labels["k"] != "v" {
+		t.Fatalf("unexpected collections: %+v", u)
+	}
+}
+
+func TestFromStruct_Constraints(t *testing.T) {
+	s := g.FromStruct[fsUser]().MustBind()
+	_, err := s.Parse(context.Background(), map[string]any{
+		"name":      "much-too-long",
+		"email":     "nope",
+		"role":      "root",
+		"age":       200,
+		"createdAt": "yesterday",
+		"home":      map[string]any{"zip": "1000001"},
+		"work":      nil,
+		"tags":      []any{"a", "b", "c"},
+	})
+	iss, ok := goskema.AsIssues(err)
+	if !ok {
+		t.Fatalf("expected issues, got %v", err)
+	}
+	want := map[string]string{
+		"/name":      goskema.CodeTooLong,
+		"/email":     goskema.CodeInvalidFormat,
+		"/role":      goskema.CodeInvalidEnum,
+		"/age":       goskema.CodeTooBig,
+		"/createdAt": goskema.CodeInvalidFormat,
+		"/home/city": goskema.CodeRequired,
+		"/home/zip":  goskema.CodePattern,
+		"/tags":      goskema.CodeTooLong,
+	}
+	got := map[string]string{}
+	for _, it := range iss {
+		got[it.Path] = it.Code
+	}
+	for p, c := range want {
+		if got[p] != c {
+			t.Errorf("%s: want %s, got %q (all: %v)", p, c, got[p], iss)
+		}
+	}
+	if _, ok := got["/work"]; ok {
+		t.Errorf("null pointer field should be accepted: %v", iss)
+	}
+}
+
+func TestFromStruct_RequiredAndRefine(t *testing.T) {
+	s := g.FromStruct[fsAddress]().
+		RefineCtx("tokyo-zip", func(dc goskema.DomainCtx[fsAddress], a fsAddress) []goskema.Issue {
+			if a.City == "Tokyo" && !strings.HasPrefix(a.Zip, "1") {
+				return []goskema.Issue{{Path: "/zip", Code: goskema.CodeBusinessRule, Message: "tokyo zip"}}
+			}
+			return nil
+		}).
+		MustBind()
+	if _, err := s.Parse(context.Background(), map[string]any{}); err == nil {
+		t.Fatal("expected required error for city")
+	}
+	_, err := s.Parse(context.Background(), map[string]any{"city": "Tokyo", "zip": "500-0001"})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Code != goskema.CodeBusinessRule {
+		t.Fatalf("expected business rule issue, got %v", err)
+	}
+}
+
+func TestFromStruct_ValidateValue(t *testing.T) {
+	s := g.FromStruct[fsAddress]().MustBind()
+	if err := s.ValidateValue(context.Background(), fsAddress{City: "x", Zip: "bad"}); err == nil {
+		t.Fatal("expected pattern error")
+	}
+	if err := s.ValidateValue(context.Background(), fsAddress{City: "x", Zip: "100-0001"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type fsNode struct {
+	Name     string    `json:"name" goskema:"required"`
+	Children []*fsNode `json:"children"`
+}
+
+func TestFromStruct_Recursive(t *testing.T) {
+	s := g.FromStruct[fsNode]().MustBind()
+	n, err := s.Parse(context.Background(), map[string]any{
+		"name":     "root",
+		"children": []any{map[string]any{"name": "a", "children": []any{map[string]any{"name": "b"}}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n.Children[0].Children[0].Name != "b" {
+		t.Fatalf("unexpected tree: %+v", n)
+	}
+	_, err = s.Parse(context.Background(), map[string]any{
+		"name":     "root",
+		"children": []any{map[string]any{"children": []any{map[string]any{}}}},
+	})
+	iss, _ := goskema.AsIssues(err)
+	paths := map[string]bool{}
+	for _, it := range iss {
+		paths[it.Path] = true
+	}
+	if !paths["/children/0/name"] || !paths["/children/0/children/0/name"] {
+		t.Fatalf("expected nested required issues, got %v", iss)
+	}
+}
+
+func TestFromStruct_JSONSchema(t *testing.T) {
+	s := g.FromStruct[fsUser]().MustBind()
+	js, err := s.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(js)
+	out := string(b)
+	for _, frag := range []string{
+		`"required":["name"]`,
+		`"name":{"type":"string","minLength":1,"maxLength":8}`,
+		`"createdAt":{"type":"string","format":"date-time"}`,
+		`"tags":{"type":"array","items":{"type":"string"},"maxItems":2}`,
+		`"active":{"type":"boolean","default":true}`,
+		`"labels":{"type":"object","additionalProperties":{"type":"string"}}`,
+	} {
+		if !strings.Contains(out, frag) {
+			t.Errorf("missing %s in %s", frag, out)
+		}
+	}
+	if strings.Contains(out, "Skipped") || strings.Contains(out, "secret") {
+		t.Errorf("unexpected skipped fields in %s", out)
+	}
+}
+
+func TestFromStruct_TagErrors(t *testing.T) {
+	type badOption struct {
+		A string `json:"a" goskema:"requred"`
+	}
+	type badPattern struct {
+		A string `json:"a" goskema:"pattern=("`
+	}
+	type badKind struct {
+		A chan int `json:"a"`
+	}
+	type badEnum struct {
+		A int `json:"a" goskema:"enum=1|2"`
+	}
+	type badDefault struct {
+		A int `json:"a" goskema:"min=1,default=0"`
+	}
+	for name, bind := range map[string]func() error{
+		"option":  func() error { _, err := g.FromStruct[badOption]().Bind(); return err },
+		"pattern": func() error { _, err := g.FromStruct[badPattern]().Bind(); return err },
+		"kind":    func() error { _, err := g.FromStruct[badKind]().Bind(); return err },
+		"enum":    func() error { _, err := g.FromStruct[badEnum]().Bind(); return err },
+		"default": func() error { _, err := g.FromStruct[badDefault]().Bind(); return err },
+	} {
+		iss, ok := goskema.AsIssues(bind())
+		if !ok || len(iss) == 0 || iss[0].Path != "/a" {
+			t.Errorf("%s: expected a bind error at /a, got %v", name, iss)
+		}
+	}
+}
+
+func TestFromStruct_PatternWithComma(t *testing.T) {
+	type code struct {
+		C string `json:"c" goskema:"required,pattern=^[a-z]{2,3}$"`
+	}
+	s := g.FromStruct[code]().MustBind()
+	if _, err := s.Parse(context.Background(), map[string]any{"c": "abc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.Parse(context.Background(), map[string]any{"c": "abcd"}); err == nil {
+		t.Fatal("expected pattern error")
+	}
+}
+
+func TestFromStruct_ParseFrom(t *testing.T) {
+	s := g.FromStruct[fsUser]().MustBind()
+	u, err := goskema.ParseFrom(context.Background(), s, goskema.JSONBytes([]byte(`{"name":"bob","age":7,"home":{"city":"Nagoya"},"tags":["a"]}`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if u.Name != "bob" || u.Age != 7 || u.Home.City != "Nagoya" || u.Role != "member" || len(u.Tags) != 1 {
+		t.Fatalf("unexpected value: %+v", u)
+	}
+}
+
+func TestFromStruct_TimeEncodesUTC(t *testing.T) {
+	s := g.FromStruct[fsMeta]().MustBind()
+	at := time.Date(2024, 1, 2, 12, 4, 5, 0, time.FixedZone("JST", 9*60*60))
+	out, err := goskema.EncodeToMap(context.Background(), s, fsMeta{CreatedAt: at})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if got := out["createdAt"]; got != "2024-01-02T03:04:05Z" {
+		t.Fatalf("expected the codec's UTC form, got %v", got)
+	}
+}
+
+type fsBatch struct {
+	IDs    []int64          `json:"ids" goskema:"min=1"`
+	Counts map[string]int64 `json:"counts" goskema:"max=1"`
+	Items  []fsAddress      `json:"items"`
+}
+
+func TestFromStruct_CollectionsAndInt64(t *testing.T) {
+	ctx := context.Background()
+	s := g.FromStruct[fsBatch]().MustBind()
+	b, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"ids":[9223372036854775807],"counts":{"a":-9223372036854775808},"items":[{"city":"Kyoto"}]}`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.IDs[0] != math.MaxInt64 || b.Counts["a"] != math.MinInt64 || b.Items[0].City != "Kyoto" {
+		t.Fatalf("unexpected value: %+v", b)
+	}
+
+	// bounds and element issues from Array/MapKV, in both parse paths
+	raw := `{"ids":[],"counts":{"a":1,"b":2},"items":[{"city":""}]}`
+	var m map[string]any
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	_ = dec.Decode(&m)
+	_, err1 := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(raw)))
+	_, err2 := s.Parse(ctx, m)
+	for _, err := range []error{err1, err2} {
+		got := map[string]string{}
+		iss, _ := goskema.AsIssues(err)
+		for _, it := range iss {
+			got[it.Path] = it.Code
+		}
+		if got["/ids"] != goskema.CodeTooShort || got["/counts"] != goskema.CodeTooLong || got["/items/0/city"] != goskema.CodeTooShort {
+			t.Fatalf("unexpected issues: %v", iss)
+		}
+	}
+
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"ids":[9223372036854775808]}`))); err == nil {
+		t.Fatalf("expected int64 overflow")
+	}
+	deep := `{"items":[{"city":"x","extra":` + strings.Repeat(`[`, 10) + strings.Repeat(`]`, 10) + `}]}`
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 5}); err == nil {
+		t.Fatalf("expected max depth error inside the slice")
+	}
+}
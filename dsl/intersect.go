@@ -0,0 +1,192 @@
+package dsl
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// AllOf merges object schemas built with Object() into one object builder whose values must
+// satisfy every part (e.g. a shared "auditable" object plus a resource-specific object).
+//
+//   - Known fields are merged; required sets and aliases are combined; refines and typed rules are
+//     kept. An alias that two parts register for different fields is reported by Build.
+//   - A field declared by several parts must satisfy each definition (JSON Schema: allOf on the
+//     property). Definitions with different JSON types conflict and are reported by Build.
+//   - One unknown-key policy applies to the merged key set, so fields of one part are never
+//     unknown to another. The strictest policy of the parts is used (Strict > Strip > Passthrough);
+//     it can be overridden on the returned builder.
+//
+// The merged schema exports a flattened object in JSON Schema.
+func AllOf(schemas ...goskema.Schema[map[string]any]) *objectBuilder {
+	b := Object()
+	policySet := false
+	for i, s := range schemas {
+		o, ok := s.(*objectSchema)
+		if !ok {
+			b.buildIssues = append(b.buildIssues, goskema.Issue{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "AllOf part " + strconv.Itoa(i) + " is not an object schema built with Object()"})
+			continue
+		}
+		for _, k := range o.sortedKnownKeys() {
+			ad := o.fields[k]
+			prev, exists := b.fields[k]
+			if !exists {
+				b.fields[k] = ad
+				continue
+			}
+			merged, err := intersectField(prev, ad)
+			if err != nil {
+				b.buildIssues = append(b.buildIssues, goskema.Issue{Path: "/" + k, Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: err.Error() + " (AllOf part " + strconv.Itoa(i) + ")"})
+				continue
+			}
+			b.fields[k] = merged
+		}
+		for _, alias := range o.sortedAliases {
+			b.addAliases(o.aliases[alias], []string{alias})
+		}
+		for k := range o.required {
+			b.required[k] = struct{}{}
+		}
+		b.refines = append(b.refines, o.refines...)
+		b.conds = append(b.conds, o.conds...)
+		if raw, ok := o.typedRulesAny.([]any); ok {
+			b.typedRules = append(b.typedRules, raw...)
+		}
+		switch {
+		case !policySet:
+			b.unknownPolicy, b.unknownTarget, policySet = o.unknownPolicy, o.unknownTarget, true
+		case o.unknownPolicy == goskema.UnknownPassthrough && b.unknownPolicy == goskema.UnknownPassthrough && o.unknownTarget != b.unknownTarget:
+			b.buildIssues = append(b.buildIssues, goskema.Issue{Path: "/", Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: "AllOf parts pass unknown keys through to different targets: " + b.unknownTarget + ", " + o.unknownTarget})
+		case unknownStrictness(o.unknownPolicy) > unknownStrictness(b.unknownPolicy):
+			b.unknownPolicy, b.unknownTarget = o.unknownPolicy, o.unknownTarget
+		}
+	}
+	return b
+}
+
+// Intersect is AllOf for two schemas.
+// Example: Intersect(auditable, resource).UnknownStrip().MustBuild()
+func Intersect(a, b goskema.Schema[map[string]any]) *objectBuilder { return AllOf(a, b) }
+
+// unknownStrictness orders unknown-key policies from most permissive to strictest.
+func unknownStrictness(p goskema.UnknownPolicy) int {
+	switch p {
+	case goskema.UnknownStrict:
+		return 2
+	case goskema.UnknownStrip:
+		return 1
+	}
+	return 0
+}
+
+// fieldConflict describes why two field definitions cannot be intersected.
+type fieldConflict string
+
+func (e fieldConflict) Error() string { return string(e) }
+
+// intersectField combines two definitions of the same field. The value must satisfy both, and
+// the first adapter's parsed value is used; stages of either definition (Preprocess/Transform)
+// report presence. Definitions with the same JSON Schema export are exported once. Different
+// JSON types or different defaults are conflicts.
+func intersectField(a, b AnyAdapter) (AnyAdapter, error) {
+	ex := &jsonExport{}
+	sa, sb := adapterJSONSchema(a, ex), adapterJSONSchema(b, ex)
+	ja, _ := json.Marshal(sa)
+	jb, _ := json.Marshal(sb)
+	same := string(ja) == string(jb)
+	if sa.Type != "" && sb.Type != "" && sa.Type != sb.Type {
+		return AnyAdapter{}, fieldConflict("conflicting field types: " + sa.Type + " vs " + sb.Type)
+	}
+	if sa.Default != nil && sb.Default != nil && !reflect.DeepEqual(sa.Default, sb.Default) {
+		return AnyAdapter{}, fieldConflict("conflicting field defaults")
+	}
+	// start from a so that adapter flags (encode, derived/computed defaults, ...) carry over;
+	// streaming would bypass b, so it is left to parse
+	out := a
+	out.parseFromSource = nil
+	out.access = a.access | b.access
+	out.staged = a.staged || b.staged
+	out.nullable = a.nullable && b.nullable
+	if out.buildErr == nil {
+		out.buildErr = b.buildErr
+	}
+	out.parse = func(ctx context.Context, v any) (any, error) {
+		pv, err := a.parse(stageInnerCtx(ctx, a.staged), v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := b.parse(stageInnerCtx(ctx, b.staged), v); err != nil {
+			return nil, err
+		}
+		return pv, nil
+	}
+	// a rebuilt adapter (DeepPartial) gets a's wrappers and must still satisfy b
+	out = out.withRewrap(func(x AnyAdapter) AnyAdapter {
+		if m, err := intersectField(x, b); err == nil {
+			return m
+		}
+		return x
+	})
+	out.validateValue = func(ctx context.Context, v any) error {
+		for _, ad := range []AnyAdapter{a, b} {
+			if ad.validateValue == nil {
+				continue
+			}
+			if err := ad.validateValue(ctx, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	switch {
+	case a.applyDefault != nil:
+		out.applyDefault = func(ctx context.Context) (any, error) {
+			dv, err := a.applyDefault(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if b.validateValue != nil {
+				if err := b.validateValue(ctx, dv); err != nil {
+					return nil, err
+				}
+			}
+			return dv, nil
+		}
+	case b.applyDefault != nil:
+		out.computedDefault, out.derivedDefault = b.computedDefault, b.derivedDefault
+		out.applyDefault = func(ctx context.Context) (any, error) {
+			dv, err := b.applyDefault(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if a.validateValue != nil {
+				if err := a.validateValue(ctx, dv); err != nil {
+					return nil, err
+				}
+			}
+			return dv, nil
+		}
+	}
+	out.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
+		if same {
+			return adapterJSONSchema(a, ex), nil
+		}
+		return &js.Schema{AllOf: []*js.Schema{adapterJSONSchema(a, ex), adapterJSONSchema(b, ex)}}, nil
+	}
+	return out, nil
+}
+
+// adapterJSONSchema returns the adapter's JSON Schema, or the empty schema when unavailable.
+func adapterJSONSchema(ad AnyAdapter, ex *jsonExport) *js.Schema {
+	if ad.jsonSchema != nil {
+		if s, err := ad.jsonSchema(ex); err == nil && s != nil {
+			return s
+		}
+	}
+	return &js.Schema{}
+}
@@ -0,0 +1,144 @@
+package dsl_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func auditable() goskema.Schema[map[string]any] {
+	return g.Object().
+		Field("createdBy", g.StringOf[string]()).Required().
+		Field("name", g.StringOf[string]()).
+		UnknownStrict().
+		MustBuild()
+}
+
+func TestAllOf_MergesFieldsRequiredAndUnknownPolicy(t *testing.T) {
+	ctx := context.Background()
+	resource := g.Object().
+		Field("name", g.StringOf[string]()).Required().
+		Field("size", g.IntOf[int]()).
+		UnknownStrip().
+		MustBuild()
+	s := g.Intersect(auditable(), resource).MustBuild()
+
+	v, err := s.Parse(ctx, map[string]any{"createdBy": "u", "name": "n", "size": 1})
+	if err != nil || !reflect.DeepEqual(v, map[string]any{"createdBy": "u", "name": "n", "size": 1}) {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+
+	// required from both parts; strict policy (from auditable) applies to the merged key set
+	_, err = s.Parse(ctx, map[string]any{"extra": true})
+	iss, _ := goskema.AsIssues(err)
+	got := map[string]string{}
+	for _, it := range iss {
+		got[it.Path] = it.Code
+	}
+	want := map[string]string{"/createdBy": goskema.CodeRequired, "/name": goskema.CodeRequired, "/extra": goskema.CodeUnknownKey}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("issues mismatch: %+v", iss)
+	}
+
+	// the policy can be overridden on the merged builder
+	loose := g.AllOf(auditable(), resource).UnknownStrip().MustBuild()
+	if v, err := loose.Parse(ctx, map[string]any{"createdBy": "u", "name": "n", "extra": 1}); err != nil || len(v) != 2 {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+}
+
+func TestAllOf_SharedFieldMustSatisfyBoth(t *testing.T) {
+	ctx := context.Background()
+	a := g.Object().Field("name", g.StringOfSchema[string](g.String().MinLen(2))).MustBuild()
+	b := g.Object().Field("name", g.StringOfSchema[string](g.String().MaxLen(3))).MustBuild()
+	s := g.AllOf(a, b).MustBuild()
+
+	if _, err := s.Parse(ctx, map[string]any{"name": "abc"}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	_, err := s.Parse(ctx, map[string]any{"name": "abcd"})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/name" || iss[0].Code != goskema.CodeTooLong {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	js, _ := s.JSONSchema()
+	if js.Type != "object" || len(js.Properties["name"].AllOf) != 2 {
+		t.Fatalf("expected flattened object with allOf on the shared property: %+v", js)
+	}
+}
+
+func TestAllOf_ConflictsReportedAtBuild(t *testing.T) {
+	a := g.Object().Field("id", g.StringOf[string]()).MustBuild()
+	b := g.Object().Field("id", g.IntOf[int]()).MustBuild()
+	_, err := g.AllOf(a, b).Build()
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/id" || iss[0].Code != goskema.CodeConflict {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	u := g.Object().Discriminator("type").OneOf(g.Variant("A", a)).MustBuild()
+	if _, err := g.AllOf(a, u).Build(); err == nil {
+		t.Fatalf("expected error for non-object part")
+	}
+}
+
+func TestAllOf_KeepsDerivedDefault(t *testing.T) {
+	ctx := context.Background()
+	a := g.Object().
+		Field("title", g.StringOf[string]()).
+		Field("slug", g.StringOf[string]()).DefaultFrom(func(partial map[string]any) any {
+		if title, ok := partial["title"].(string); ok {
+			return strings.ToLower(title)
+		}
+		return nil
+	}).
+		MustBuild()
+	b := g.Object().
+		Field("slug", g.SchemaOf[string](g.String().MinLen(1))).
+		MustBuild()
+	s := g.AllOf(a, b).MustBuild()
+
+	v, err := s.Parse(ctx, map[string]any{"title": "Hello"})
+	if err != nil || v["slug"] != "hello" {
+		t.Fatalf("derived default under AllOf: %v %v", v, err)
+	}
+}
+
+func TestAllOf_IdenticalExportsStillApplyBothDefinitions(t *testing.T) {
+	ctx := context.Background()
+	nullable := g.Object().Field("name", g.StringOf[string]().Nullable()).MustBuild()
+	plain := g.Object().Field("name", g.StringOf[string]()).MustBuild()
+
+	// Nullable does not change the export, but the plain definition still rejects null in either order
+	for _, s := range []goskema.Schema[map[string]any]{g.AllOf(nullable, plain).MustBuild(), g.AllOf(plain, nullable).MustBuild()} {
+		if _, err := s.Parse(ctx, map[string]any{"name": nil}); err == nil {
+			t.Fatalf("expected null to be rejected by the non-nullable part")
+		}
+		js, _ := s.JSONSchema()
+		if p := js.Properties["name"]; p.Type != "string" || len(p.AllOf) != 0 {
+			t.Fatalf("identical definitions should export once: %+v", p)
+		}
+	}
+}
+
+func TestAllOf_MergesAliases(t *testing.T) {
+	ctx := context.Background()
+	a := g.Object().Field("username", g.StringOf[string]()).Alias("login").MustBuild()
+	b := g.Object().Field("email", g.StringOf[string]()).Alias("mail").MustBuild()
+	s := g.AllOf(a, b).MustBuild()
+	v, err := s.Parse(ctx, map[string]any{"login": "u", "mail": "m"})
+	if err != nil || v["username"] != "u" || v["email"] != "m" {
+		t.Fatalf("aliases under AllOf: %v %v", v, err)
+	}
+
+	c := g.Object().Field("email", g.StringOf[string]()).Alias("login").MustBuild()
+	_, err = g.AllOf(a, c).Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/login" || iss[0].Code != goskema.CodeConflict {
+		t.Fatalf("expected alias conflict at /login, got %v", err)
+	}
+}
@@ -52,6 +52,14 @@ func ToIRFromSchemaDynamic(s any) ir.Schema {
 				}
 			}
 		}
+		// aliases: map[alias]canonical, inverted per field (sorted via sortedAliases)
+		aliases := map[string][]string{}
+		if am, ok := getPrivateField(s, "aliases").(map[string]string); ok {
+			sorted, _ := getPrivateField(s, "sortedAliases").([]string)
+			for _, a := range sorted {
+				aliases[am[a]] = append(aliases[am[a]], a)
+			}
+		}
 		// fields: map[string]AnyAdapter-like; iterate keys and recurse on adapter.orig
 		if fieldsAny := getPrivateField(s, "fields"); fieldsAny != nil {
 			rv := reflect.ValueOf(fieldsAny)
@@ -70,9 +78,11 @@ func ToIRFromSchemaDynamic(s any) ir.Schema {
 					if sch == nil {
 						return nil
 					}
-					f := ir.Field{Name: name, Schema: sch}
-					// capture default when applyDefault is present
-					if defFn := getPrivateField(ad.Interface(), "applyDefault"); defFn != nil {
+					f := ir.Field{Name: name, Schema: sch, Aliases: aliases[name]}
+					// capture static defaults; DefaultFunc/DefaultFrom are computed at parse time and
+					// must not run here
+					computed, _ := getPrivateField(ad.Interface(), "computedDefault").(bool)
+					if defFn := getPrivateField(ad.Interface(), "applyDefault"); defFn != nil && !computed {
 						dfv := reflect.ValueOf(defFn)
 						if dfv.Kind() == reflect.Func && !dfv.IsNil() && dfv.Type().NumIn() == 1 && dfv.Type().NumOut() == 2 {
 							// expect func(context.Context) (any, error)
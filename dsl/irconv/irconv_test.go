@@ -1,6 +1,7 @@
 package irconv
 
 import (
+	"context"
 	"testing"
 
 	g "github.com/reoring/goskema"
@@ -78,3 +79,43 @@ func TestToIRFromSchemaDynamic_DefaultCaptured(t *testing.T) {
 		t.Fatalf("field 'active' not found")
 	}
 }
+
+func TestToIRFromSchemaDynamic_AliasesCaptured(t *testing.T) {
+	s, err := d.Object().
+		Field("username", d.StringOf[string]()).Alias("userName", "user_name").
+		Build()
+	if err != nil {
+		t.Fatalf("build schema: %v", err)
+	}
+	obj, ok := ToIRFromSchemaDynamic(s).(*ir.Object)
+	if !ok || len(obj.Fields) != 1 {
+		t.Fatalf("unexpected node: %#v", obj)
+	}
+	if got := obj.Fields[0].Aliases; len(got) != 2 || got[0] != "userName" || got[1] != "user_name" {
+		t.Fatalf("aliases = %v", got)
+	}
+}
+
+func TestToIRFromSchemaDynamic_ComputedDefaultNotCaptured(t *testing.T) {
+	calls := 0
+	s, err := d.Object().
+		Field("id", d.StringOf[string]()).DefaultFunc(func(ctx context.Context) (any, error) { calls++; return g.NewID(ctx), nil }).
+		Field("title", d.StringOf[string]()).
+		Field("slug", d.StringOf[string]()).DefaultFrom(func(partial map[string]any) any { calls++; return "slug" }).
+		Build()
+	if err != nil {
+		t.Fatalf("build schema: %v", err)
+	}
+	obj, ok := ToIRFromSchemaDynamic(s).(*ir.Object)
+	if !ok {
+		t.Fatalf("expected *ir.Object")
+	}
+	for _, f := range obj.Fields {
+		if f.Default != nil {
+			t.Fatalf("field %s: computed default captured as %v", f.Name, f.Default)
+		}
+	}
+	if calls != 0 {
+		t.Fatalf("default functions ran %d times during IR conversion", calls)
+	}
+}
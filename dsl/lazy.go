@@ -0,0 +1,194 @@
+package dsl
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	eng "github.com/reoring/goskema/internal/engine"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// lazySeq numbers unnamed Lazy schemas for their $defs entry.
+var lazySeq atomic.Int64
+
+// Lazy defers construction of a schema until it is first used, so that a schema can refer to
+// itself (trees, comment threads, expression languages).
+// Example:
+//
+//	var node goskema.Schema[map[string]any]
+//	node = Object().
+//		Field("value", StringOf[string]()).Required().
+//		Field("next", Lazy(func() AnyAdapter { return SchemaOf(node) })).
+//		MustBuild()
+//
+// JSON Schema exports the target once under `$defs` and refers to it with `$ref`.
+func Lazy(fn func() AnyAdapter) AnyAdapter { return LazyNamed("", fn) }
+
+// LazyNamed is Lazy with an explicit `$defs` name (e.g. "Node"). Lazy schemas sharing a name
+// must export the same definition; marshaling a JSON Schema with two different ones fails.
+func LazyNamed(name string, fn func() AnyAdapter) AnyAdapter {
+	return anyAdapterFromSchema[any](newLazy[any](name, fn))
+}
+
+// LazySchema is the typed form of Lazy for element positions that take a Schema.
+// Example: ArrayOf[map[string]any](LazySchema("Node", func() goskema.Schema[map[string]any] { return node }))
+// An empty name is replaced by a generated one.
+func LazySchema[T any](name string, fn func() goskema.Schema[T]) goskema.Schema[T] {
+	return newLazy[T](name, func() AnyAdapter { return anyAdapterFromSchema[T](fn()) })
+}
+
+func newLazy[T any](name string, fn func() AnyAdapter) *lazySchema[T] {
+	if name == "" {
+		name = "lazy" + strconv.FormatInt(lazySeq.Add(1), 10)
+	}
+	return &lazySchema[T]{name: name, fn: fn}
+}
+
+type lazySchema[T any] struct {
+	name string
+	fn   func() AnyAdapter
+	once sync.Once
+	ad   AnyAdapter
+}
+
+// resolve builds the target adapter on first use.
+func (l *lazySchema[T]) resolve() AnyAdapter {
+	l.once.Do(func() { l.ad = l.fn() })
+	return l.ad
+}
+
+func (l *lazySchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	var zero T
+	pv, err := l.resolve().parse(ctx, v)
+	if err != nil {
+		return zero, err
+	}
+	return lazyValue[T](pv)
+}
+
+// lazyValue converts a value parsed by the target to T; a value of another type (the target
+// does not produce T) is an invalid_type issue.
+func lazyValue[T any](pv any) (T, error) {
+	var zero T
+	if pv == nil {
+		return zero, nil
+	}
+	tv, ok := pv.(T)
+	if !ok {
+		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "lazy target produced " + reflect.TypeOf(pv).String() + ", expected " + reflect.TypeOf((*T)(nil)).Elem().String()}}
+	}
+	return tv, nil
+}
+
+func (l *lazySchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (T, error) { return l.Parse(ctx, v) })
+}
+
+// ---- streaming SPI ----
+// ParseFromSource streams through the target when it supports it; depth limits are enforced by
+// the enclosing source, so recursion is bounded by ParseOpt.MaxDepth. Otherwise the value is
+// buffered with the depth, size and duplicate-key limits of opt and then parsed.
+func (l *lazySchema[T]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (T, error) {
+	var zero T
+	if ad := l.resolve(); ad.parseFromSource != nil {
+		pv, err := ad.parseFromSource(ctx, src, opt)
+		if err != nil {
+			return zero, err
+		}
+		return lazyValue[T](pv)
+	}
+	engSrc := goskema.EngineTokenSource(src)
+	var collected []eng.SimpleIssue
+	dup := eng.DupIgnore
+	switch opt.Strictness.OnDuplicateKey {
+	case goskema.Error:
+		dup = eng.DupError
+	case goskema.Warn:
+		dup = eng.DupWarn
+	}
+	enforced := eng.WrapWithEnforcement(engSrc, eng.EnforceOptions{
+		OnDuplicate: dup,
+		MaxDepth:    opt.MaxDepth,
+		MaxBytes:    opt.MaxBytes,
+		IssueSink: func(si eng.SimpleIssue) {
+			collected = append(collected, si)
+		},
+		FailFast: opt.FailFast,
+	})
+	var anyVal any
+	var err error
+	if src.NumberMode() == goskema.NumberFloat64 {
+		anyVal, err = eng.DecodeAnyFromSourceAsFloat64(enforced)
+	} else {
+		anyVal, err = eng.DecodeAnyFromSource(enforced)
+	}
+	if err != nil {
+		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+	}
+	if len(collected) > 0 && !goskema.IsFailFast(ctx) {
+		var iss goskema.Issues
+		for _, si := range collected {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: si.Path, Code: si.Code, Message: si.Message})
+		}
+		return zero, iss
+	}
+	return l.Parse(ctx, anyVal)
+}
+
+func (l *lazySchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[T], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (T, error) { return l.ParseFromSource(ctx, src, opt) })
+}
+
+func (l *lazySchema[T]) TypeCheck(ctx context.Context, v any) error {
+	_, err := l.Parse(ctx, v)
+	return err
+}
+
+func (l *lazySchema[T]) RuleCheck(ctx context.Context, v any) error { return nil }
+
+func (l *lazySchema[T]) Validate(ctx context.Context, v any) error {
+	if err := l.TypeCheck(ctx, v); err != nil {
+		return err
+	}
+	return l.RuleCheck(ctx, v)
+}
+
+func (l *lazySchema[T]) ValidateValue(ctx context.Context, v T) error {
+	ad := l.resolve()
+	if ad.validateValue == nil {
+		return nil
+	}
+	return ad.validateValue(ctx, v)
+}
+
+// JSONSchema returns a `$ref` to the target. The outermost occurrence also carries the
+// definition under `$defs`; recursive occurrences are bare references. Nested `$defs` are
+// moved to the document root when the schema is marshaled. Recursion is tracked per export, so
+// concurrent exports each get the full definition.
+func (l *lazySchema[T]) JSONSchema() (*js.Schema, error) { return l.exportJSONSchema(&jsonExport{}) }
+
+func (l *lazySchema[T]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	ref := &js.Schema{Ref: "#/$defs/" + l.name}
+	if ex.visiting[l] {
+		return ref, nil
+	}
+	if ex.visiting == nil {
+		ex.visiting = map[any]bool{}
+	}
+	ex.visiting[l] = true
+	defer delete(ex.visiting, l)
+	def := &js.Schema{}
+	if ad := l.resolve(); ad.jsonSchema != nil {
+		var err error
+		if def, err = ad.jsonSchema(ex); err != nil {
+			return nil, err
+		}
+	}
+	ref.Defs = map[string]*js.Schema{l.name: def}
+	return ref, nil
+}
@@ -0,0 +1,194 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+func treeSchema() goskema.Schema[map[string]any] {
+	var node goskema.Schema[map[string]any]
+	node = g.Object().
+		Field("value", g.StringOf[string]()).Required().
+		Field("children", g.ArrayOf[map[string]any](g.LazySchema("Node", func() goskema.Schema[map[string]any] { return node }))).
+		UnknownStrict().
+		MustBuild()
+	return node
+}
+
+func TestLazy_RecursiveParse(t *testing.T) {
+	ctx := context.Background()
+	node := treeSchema()
+	in := map[string]any{"value": "root", "children": []any{
+		map[string]any{"value": "a", "children": []any{map[string]any{"value": "a1"}}},
+		map[string]any{"value": "b"},
+	}}
+	if _, err := node.Parse(ctx, in); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if _, err := node.ParseWithMeta(ctx, in); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+
+	bad := map[string]any{"value": "root", "children": []any{map[string]any{"children": []any{map[string]any{"value": 1}}}}}
+	_, err := node.Parse(ctx, bad)
+	iss, _ := goskema.AsIssues(err)
+	paths := map[string]string{}
+	for _, it := range iss {
+		paths[it.Path] = it.Code
+	}
+	if paths["/children/0/value"] != goskema.CodeRequired || paths["/children/0/children/0/value"] != goskema.CodeInvalidType {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+func TestLazy_Streaming_HonorsMaxDepth(t *testing.T) {
+	ctx := context.Background()
+	var list goskema.Schema[map[string]any]
+	list = g.Object().
+		Field("v", g.IntOf[int]()).Required().
+		Field("next", g.Lazy(func() g.AnyAdapter { return g.SchemaOf(list) })).
+		MustBuild()
+
+	doc := `{"v":1,"next":{"v":2,"next":{"v":3}}}`
+	v, err := goskema.ParseFrom(ctx, list, goskema.JSONBytes([]byte(doc)))
+	if err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if v["next"].(map[string]any)["next"].(map[string]any)["v"] != 3 {
+		t.Fatalf("unexpected value: %v", v)
+	}
+
+	deep := strings.Repeat(`{"v":1,"next":`, 10) + `{"v":1}` + strings.Repeat(`}`, 10)
+	if _, err := goskema.ParseFrom(ctx, list, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 5}); err == nil {
+		t.Fatalf("expected max depth error")
+	}
+	if _, err := goskema.ParseFrom(ctx, list, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 20}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+}
+
+func TestLazy_BufferedTarget_HonorsLimits(t *testing.T) {
+	ctx := context.Background()
+	// MapAny has no streaming path, so the Lazy buffers the value itself
+	m := g.LazySchema("Bag", func() goskema.Schema[map[string]any] { return g.MapAny() })
+	deep := strings.Repeat(`{"a":`, 10) + `1` + strings.Repeat(`}`, 10)
+	if _, err := goskema.ParseFrom(ctx, m, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 5}); err == nil {
+		t.Fatalf("expected max depth error")
+	}
+	dup := goskema.ParseOpt{Strictness: goskema.Strictness{OnDuplicateKey: goskema.Error}}
+	if _, err := goskema.ParseFrom(ctx, m, goskema.JSONBytes([]byte(`{"a":1,"a":2}`)), dup); err == nil {
+		t.Fatalf("expected duplicate key error")
+	}
+	if v, err := goskema.ParseFrom(ctx, m, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 20}); err != nil || v["a"] == nil {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+}
+
+func TestLazy_JSONSchema_DefsAndRef(t *testing.T) {
+	s, err := treeSchema().JSONSchema()
+	if err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var doc map[string]any
+	_ = json.Unmarshal(b, &doc)
+	defs, ok := doc["$defs"].(map[string]any)
+	if !ok || defs["Node"] == nil {
+		t.Fatalf("expected root $defs.Node: %s", b)
+	}
+	items := doc["properties"].(map[string]any)["children"].(map[string]any)["items"].(map[string]any)
+	if items["$ref"] != "#/$defs/Node" || items["$defs"] != nil {
+		t.Fatalf("expected bare $ref in items: %s", b)
+	}
+	inner := defs["Node"].(map[string]any)["properties"].(map[string]any)["children"].(map[string]any)["items"].(map[string]any)
+	if inner["$ref"] != "#/$defs/Node" {
+		t.Fatalf("expected recursive $ref: %s", b)
+	}
+}
+
+// rendezvousSchema holds its first n JSON Schema exports until all of them have started.
+type rendezvousSchema struct {
+	goskema.Schema[string]
+	n       int32
+	arrived *atomic.Int32
+	all     chan struct{}
+}
+
+func (r rendezvousSchema) JSONSchema() (*js.Schema, error) {
+	switch k := r.arrived.Add(1); {
+	case k == r.n:
+		close(r.all)
+	case k < r.n:
+		select {
+		case <-r.all:
+		case <-time.After(time.Second):
+		}
+	}
+	return r.Schema.JSONSchema()
+}
+
+func TestLazy_JSONSchema_ConcurrentExports(t *testing.T) {
+	const n = 4
+	var node goskema.Schema[map[string]any]
+	node = g.Object().
+		Field("value", g.SchemaOf[string](rendezvousSchema{Schema: g.String(), n: n, arrived: new(atomic.Int32), all: make(chan struct{})})).
+		Field("next", g.LazyNamed("Node", func() g.AnyAdapter { return g.SchemaOf(node) })).
+		MustBuild()
+	s := g.LazySchema("Root", func() goskema.Schema[map[string]any] { return node })
+
+	var wg sync.WaitGroup
+	errs := make(chan string, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out, err := s.JSONSchema()
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			b, _ := json.Marshal(out)
+			var doc struct {
+				Defs map[string]any `json:"$defs"`
+			}
+			if json.Unmarshal(b, &doc) != nil || doc.Defs["Root"] == nil {
+				errs <- string(b)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Fatalf("expected every export to carry $defs.Root: %s", e)
+	}
+}
+
+func TestLazy_JSONSchema_ConflictingNames(t *testing.T) {
+	a := g.LazyNamed("Item", func() g.AnyAdapter { return g.StringOf[string]() })
+	b := g.LazyNamed("Item", func() g.AnyAdapter { return g.IntOf[int]() })
+	s, err := g.Object().Field("a", a).Field("b", b).MustBuild().JSONSchema()
+	if err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if _, err := json.Marshal(s); err == nil || !strings.Contains(err.Error(), "$defs/Item") {
+		t.Fatalf("expected conflicting $defs error, got %v", err)
+	}
+
+	// the same definition reached twice is emitted once
+	s, _ = g.Object().Field("a", a).Field("c", a).MustBuild().JSONSchema()
+	if _, err := json.Marshal(s); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+package dsl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	eng "github.com/reoring/goskema/internal/engine"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// Const returns a Schema[T] that accepts exactly v.
+// T's underlying kind must be string, bool, an integer or a float, or v is a nil interface
+// value (Const[any](nil) accepts only null); numbers are compared exactly
+// (json.Number text is not rounded through float64 for integer literals).
+// Mismatches are reported as invalid_enum with Params {"expected","got"}; JSON Schema exports `const`.
+func Const[T comparable](v T) goskema.Schema[T] { return literalSchema[T]{v: v} }
+
+// Literal adapts Const(v) to an AnyAdapter for use in object builders.
+// Example: Field("apiVersion", Literal("v1")).Required()
+func Literal[T comparable](v T) AnyAdapter {
+	return anyAdapterFromSchema[T](literalSchema[T]{v: v})
+}
+
+type literalSchema[T comparable] struct{ v T }
+
+func (s literalSchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	tv, ok := s.coerce(v)
+	if !ok {
+		var zero T
+		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected " + s.jsonType()}}
+	}
+	if err := s.ValidateValue(ctx, tv); err != nil {
+		var zero T
+		return zero, err
+	}
+	return tv, nil
+}
+
+func (s literalSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	tv, err := s.Parse(ctx, v)
+	return goskema.Decoded[T]{Value: tv, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+
+// ---- streaming SPI ----
+func (s literalSchema[T]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (T, error) {
+	raw, err := nextScalar(src, s.jsonType())
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return s.Parse(ctx, raw)
+}
+
+func (s literalSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[T], error) {
+	v, err := s.ParseFromSource(ctx, src, opt)
+	return goskema.Decoded[T]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+
+func (s literalSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	if _, ok := s.coerce(v); !ok {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected " + s.jsonType()}}
+	}
+	return nil
+}
+
+func (s literalSchema[T]) RuleCheck(ctx context.Context, v any) error {
+	tv, ok := s.coerce(v)
+	if !ok {
+		return nil
+	}
+	return s.ValidateValue(ctx, tv)
+}
+
+func (s literalSchema[T]) Validate(ctx context.Context, v any) error {
+	if err := s.TypeCheck(ctx, v); err != nil {
+		return err
+	}
+	return s.RuleCheck(ctx, v)
+}
+
+func (s literalSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if v == s.v {
+		return nil
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidEnum, Message: i18n.T(goskema.CodeInvalidEnum, nil), Hint: fmt.Sprintf("must be %#v", s.v), Params: map[string]any{"expected": s.v, "got": v}}}
+}
+
+func (s literalSchema[T]) JSONSchema() (*js.Schema, error) {
+	return &js.Schema{Type: s.jsonType(), Const: s.v}, nil
+}
+
+func (s literalSchema[T]) jsonType() string {
+	if any(s.v) == nil {
+		return "null"
+	}
+	switch reflect.TypeOf(s.v).Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return ""
+}
+
+// coerce converts a wire value (string/bool/json.Number/float64) or a Go value of a compatible
+// kind into T. It reports false when the kind does not match or a number does not fit T.
+func (s literalSchema[T]) coerce(v any) (T, bool) {
+	var zero T
+	if any(s.v) == nil {
+		return zero, v == nil
+	}
+	if tv, ok := v.(T); ok {
+		return tv, true
+	}
+	if v == nil {
+		return zero, false
+	}
+	rt := reflect.TypeOf(s.v)
+	rv := reflect.ValueOf(v)
+	switch rt.Kind() {
+	case reflect.String:
+		if rv.Kind() != reflect.String {
+			return zero, false
+		}
+		if _, isNum := v.(json.Number); isNum {
+			return zero, false
+		}
+		return rv.Convert(rt).Interface().(T), true
+	case reflect.Bool:
+		if rv.Kind() != reflect.Bool {
+			return zero, false
+		}
+		return rv.Convert(rt).Interface().(T), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		r, ok := ratOf(v)
+		if !ok || !r.IsInt() {
+			return zero, false
+		}
+		out := reflect.New(rt).Elem()
+		n := r.Num()
+		if out.CanInt() {
+			if !n.IsInt64() || out.OverflowInt(n.Int64()) {
+				return zero, false
+			}
+			out.SetInt(n.Int64())
+		} else {
+			if n.Sign() < 0 || !n.IsUint64() || out.OverflowUint(n.Uint64()) {
+				return zero, false
+			}
+			out.SetUint(n.Uint64())
+		}
+		return out.Interface().(T), true
+	case reflect.Float32, reflect.Float64:
+		r, ok := ratOf(v)
+		if !ok {
+			return zero, false
+		}
+		f, _ := r.Float64()
+		out := reflect.New(rt).Elem()
+		out.SetFloat(f)
+		return out.Interface().(T), true
+	}
+	return zero, false
+}
+
+// nextScalar reads one scalar token (string/bool/number/null) from src as a tree-path value
+// (string, bool, json.Number or nil) so that streaming can reuse Parse. Other tokens are invalid_type.
+func nextScalar(src goskema.Source, want string) (any, error) {
+	tok, err := goskema.EngineTokenSource(src).NextToken()
+	if err != nil {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+	}
+	switch tok.Kind {
+	case eng.KindString:
+		return tok.String, nil
+	case eng.KindBool:
+		return tok.Bool, nil
+	case eng.KindNumber:
+		return json.Number(tok.Number), nil
+	case eng.KindNull:
+		return nil, nil
+	}
+	return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected " + want}}
+}
+
+// ratOf converts numeric wire/Go values into an exact rational.
+func ratOf(v any) (*big.Rat, bool) {
+	switch t := v.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(string(t))
+	case float64:
+		r := new(big.Rat)
+		if r.SetFloat64(t) == nil {
+			return nil, false
+		}
+		return r, true
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.CanInt():
+		return new(big.Rat).SetInt64(rv.Int()), true
+	case rv.CanUint():
+		return new(big.Rat).SetUint64(rv.Uint()), true
+	case rv.CanFloat():
+		r := new(big.Rat)
+		if r.SetFloat64(rv.Float()) == nil {
+			return nil, false
+		}
+		return r, true
+	}
+	return nil, false
+}
@@ -0,0 +1,101 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestLiteral_StringBoolNumber(t *testing.T) {
+	ctx := context.Background()
+
+	v1 := g.Const("v1")
+	if _, err := v1.Parse(ctx, "v1"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := v1.Parse(ctx, "v2")
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Code != goskema.CodeInvalidEnum || iss[0].Params["expected"] != "v1" || iss[0].Params["got"] != "v2" {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	if code := firstCode(t, g.Const(true).Validate(ctx, false)); code != goskema.CodeInvalidEnum {
+		t.Fatalf("want invalid_enum, got %s", code)
+	}
+	if code := firstCode(t, g.Const(true).Validate(ctx, "true")); code != goskema.CodeInvalidType {
+		t.Fatalf("want invalid_type, got %s", code)
+	}
+
+	n := g.Const(42)
+	if got, err := n.Parse(ctx, json.Number("42")); err != nil || got != 42 {
+		t.Fatalf("unexpected: %v %v", got, err)
+	}
+	if code := firstCode(t, n.Validate(ctx, json.Number("42.5"))); code != goskema.CodeInvalidType {
+		t.Fatalf("want invalid_type for fractional input, got %s", code)
+	}
+	if code := firstCode(t, n.Validate(ctx, json.Number("43"))); code != goskema.CodeInvalidEnum {
+		t.Fatalf("want invalid_enum, got %s", code)
+	}
+}
+
+func TestLiteral_ObjectField_Streaming(t *testing.T) {
+	ctx := context.Background()
+	type Manifest struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	m := g.ObjectOf[Manifest]().
+		Field("apiVersion", g.Literal("v1")).Required().
+		Field("kind", g.Literal("Pod")).Required().
+		UnknownStrict().
+		MustBind()
+
+	v, err := goskema.ParseFrom(ctx, m, goskema.JSONBytes([]byte(`{"apiVersion":"v1","kind":"Pod"}`)))
+	if err != nil || v.APIVersion != "v1" || v.Kind != "Pod" {
+		t.Fatalf("unexpected: %+v %v", v, err)
+	}
+	_, err = goskema.ParseFrom(ctx, m, goskema.JSONBytes([]byte(`{"apiVersion":"v2","kind":"Pod"}`)))
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/apiVersion" || iss[0].Code != goskema.CodeInvalidEnum {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+func TestLiteral_JSONSchema_Const(t *testing.T) {
+	s, err := g.Const("v1").JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema err: %v", err)
+	}
+	if got, want := normalize(s), normalize(map[string]any{"type": "string", "const": "v1"}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+	b, _ := g.Const(false).JSONSchema()
+	if got, want := normalize(b), normalize(map[string]any{"type": "boolean", "const": false}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+}
+
+func TestLiteral_Null(t *testing.T) {
+	ctx := context.Background()
+	null := g.Const[any](nil)
+	if v, err := null.Parse(ctx, nil); err != nil || v != nil {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	if code := firstCode(t, null.Validate(ctx, "x")); code != goskema.CodeInvalidType {
+		t.Fatalf("want invalid_type, got %s", code)
+	}
+	if _, err := goskema.ParseFrom(ctx, null, goskema.JSONBytes([]byte(`null`))); err != nil {
+		t.Fatalf("unexpected streaming err: %v", err)
+	}
+	if _, err := goskema.ParseFrom(ctx, null, goskema.JSONBytes([]byte(`0`))); err == nil {
+		t.Fatalf("expected streaming error for a non-null value")
+	}
+	s, err := g.Object().Field("deleted", g.Literal[any](nil)).MustBuild().JSONSchema()
+	if err != nil || s.Properties["deleted"].Type != "null" {
+		t.Fatalf("expected type null: %+v %v", s, err)
+	}
+}
@@ -62,7 +62,7 @@ func (m mapSchema[V]) Parse(ctx context.Context, v any) (map[string]V, error) {
 						} else {
 							p = base + "/" + p
 						}
-						out = goskema.AppendIssues(out, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause})
+						out = goskema.AppendIssues(out, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause, Params: it.Params})
 					}
 					return nil, out
 				}
@@ -80,7 +80,8 @@ func (m mapSchema[V]) Parse(ctx context.Context, v any) (map[string]V, error) {
 	case map[string]any:
 		out := make(map[string]V, len(src))
 		for k, anyVal := range src {
-			vv, err := m.val.Parse(ctx, anyVal)
+			reportEntry(ctx, k, anyVal)
+			vv, err := m.val.Parse(scopeAtKey(ctx, k), anyVal)
 			if err != nil {
 				if iss, ok := goskema.AsIssues(err); ok {
 					var outIss goskema.Issues
@@ -94,7 +95,7 @@ func (m mapSchema[V]) Parse(ctx context.Context, v any) (map[string]V, error) {
 						} else {
 							p = base + "/" + p
 						}
-						outIss = goskema.AppendIssues(outIss, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause})
+						outIss = goskema.AppendIssues(outIss, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause, Params: it.Params})
 					}
 					return nil, outIss
 				}
@@ -119,8 +120,7 @@ func (m mapSchema[V]) Parse(ctx context.Context, v any) (map[string]V, error) {
 }
 
 func (m mapSchema[V]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[map[string]V], error) {
-	mv, err := m.Parse(ctx, v)
-	return goskema.Decoded[map[string]V]{Value: mv, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (map[string]V, error) { return m.Parse(ctx, v) })
 }
 
 // ---- streaming SPI ----
@@ -156,7 +156,8 @@ func (m mapSchema[V]) ParseFromSource(ctx context.Context, src goskema.Source, o
 		if err != nil {
 			return nil, goskema.Issues{goskema.Issue{Path: "/" + k, Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
 		}
-		vv, perr := m.val.Parse(ctx, anyVal)
+		reportEntry(ctx, k, anyVal)
+		vv, perr := m.val.Parse(scopeAtKey(ctx, k), anyVal)
 		if perr != nil {
 			if iss, ok := goskema.AsIssues(perr); ok {
 				var outIss goskema.Issues
@@ -170,7 +171,7 @@ func (m mapSchema[V]) ParseFromSource(ctx context.Context, src goskema.Source, o
 					} else {
 						p = base + "/" + p
 					}
-					outIss = goskema.AppendIssues(outIss, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause})
+					outIss = goskema.AppendIssues(outIss, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause, Params: it.Params})
 				}
 				return nil, outIss
 			}
@@ -192,8 +193,7 @@ func (m mapSchema[V]) ParseFromSource(ctx context.Context, src goskema.Source, o
 }
 
 func (m mapSchema[V]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[map[string]V], error) {
-	mv, err := m.ParseFromSource(ctx, src, opt)
-	return goskema.Decoded[map[string]V]{Value: mv, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (map[string]V, error) { return m.ParseFromSource(ctx, src, opt) })
 }
 
 func (m mapSchema[V]) TypeCheck(ctx context.Context, v any) error {
@@ -226,10 +226,15 @@ func (m mapSchema[V]) ValidateValue(ctx context.Context, v map[string]V) error {
 	return nil
 }
 
-func (m mapSchema[V]) JSONSchema() (*js.Schema, error) {
-	vs, err := m.val.JSONSchema()
+func (m mapSchema[V]) JSONSchema() (*js.Schema, error) { return m.exportJSONSchema(&jsonExport{}) }
+
+func (m mapSchema[V]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	vs, err := exportJSONSchema(m.val, ex)
 	if err != nil {
 		return nil, err
 	}
 	return &js.Schema{Type: "object", AdditionalProperties: vs}, nil
 }
+
+// elemSchema exposes the value schema for nested struct projection in Bind.
+func (m mapSchema[V]) elemSchema() any { return m.val }
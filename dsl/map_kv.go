@@ -0,0 +1,352 @@
+package dsl
+
+import (
+	"context"
+	"sort"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	eng "github.com/reoring/goskema/internal/engine"
+	str "github.com/reoring/goskema/internal/stream"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// MapKVBuilder exposes property-count options for MapKV while implementing Schema[map[K]V].
+type MapKVBuilder[K ~string, V any] interface {
+	goskema.Schema[map[K]V]
+	// MinProps sets the minimum number of properties.
+	MinProps(n int) MapKVBuilder[K, V]
+	// MaxProps sets the maximum number of properties.
+	MaxProps(n int) MapKVBuilder[K, V]
+}
+
+// MapKV returns a schema for JSON objects whose keys are validated by key (projected to K) and
+// whose values are validated by val. Key issues are reported at "/<key>" with Params["key"].
+// A nil key accepts any property name and a nil val accepts any value.
+// Example: MapKV(Enum[Region]("tokyo", "osaka"), NumberJSON()).MaxProps(2)
+func MapKV[K ~string, V any](key goskema.Schema[K], val goskema.Schema[V]) MapKVBuilder[K, V] {
+	return &mapKVSchema[K, V]{key: key, val: val, minProps: -1, maxProps: -1}
+}
+
+// MapKVOf adapts MapKV to AnyAdapter for use in object builders.
+func MapKVOf[K ~string, V any](key goskema.Schema[K], val goskema.Schema[V]) AnyAdapter {
+	return anyAdapterFromSchema[map[K]V](MapKV(key, val))
+}
+
+// StringAs projects a constrained string schema to a ~string domain type (e.g. for MapKV keys).
+// Example: MapKV(StringAs[LabelKey](String().Pattern(re)), String())
+func StringAs[T ~string](sb StringBuilder) goskema.Schema[T] {
+	inner, ok := sb.(*stringSchema)
+	if !ok || inner == nil {
+		inner = newStringSchema()
+	}
+	return stringAsSchema[T]{s: inner}
+}
+
+type mapKVSchema[K ~string, V any] struct {
+	key      goskema.Schema[K]
+	val      goskema.Schema[V]
+	minProps int
+	maxProps int
+}
+
+func (m *mapKVSchema[K, V]) MinProps(n int) MapKVBuilder[K, V] { m.minProps = n; return m }
+func (m *mapKVSchema[K, V]) MaxProps(n int) MapKVBuilder[K, V] { m.maxProps = n; return m }
+
+// checkBuild delegates build-time checks to the key and value schemas.
+func (m *mapKVSchema[K, V]) checkBuild() error {
+	for _, s := range []any{m.key, m.val} {
+		if bc, ok := s.(buildChecker); ok {
+			if err := bc.checkBuild(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mapKVSchema[K, V]) countIssues(n int) goskema.Issues {
+	if m.minProps >= 0 && n < m.minProps {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeTooShort, Message: i18n.T(goskema.CodeTooShort, nil), Hint: "object has fewer properties than min", Params: map[string]any{"min": m.minProps, "got": n}}}
+	}
+	if m.maxProps >= 0 && n > m.maxProps {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeTooLong, Message: i18n.T(goskema.CodeTooLong, nil), Hint: "object has more properties than max", Params: map[string]any{"max": m.maxProps, "got": n}}}
+	}
+	return nil
+}
+
+// parseKey validates one key; issues are placed at "/<key>" and carry Params["key"].
+func (m *mapKVSchema[K, V]) parseKey(ctx context.Context, k string) (K, goskema.Issues) {
+	if m.key == nil {
+		return K(k), nil
+	}
+	kv, err := m.key.Parse(ctx, k)
+	if err == nil {
+		return kv, nil
+	}
+	var out goskema.Issues
+	for _, it := range issuesFromErr("/", err) {
+		params := map[string]any{"key": k}
+		for pk, pv := range it.Params {
+			params[pk] = pv
+		}
+		hint := it.Hint
+		if hint == "" {
+			hint = "invalid property name"
+		}
+		out = goskema.AppendIssues(out, goskema.Issue{Path: "/" + k, Code: it.Code, Message: it.Message, Hint: hint, Cause: it.Cause, Params: params})
+	}
+	return kv, out
+}
+
+// parseEntry parses one key/value pair, collecting key and value issues.
+func (m *mapKVSchema[K, V]) parseEntry(ctx context.Context, k string, raw any) (K, V, goskema.Issues) {
+	kv, iss := m.parseKey(ctx, k)
+	reportEntry(ctx, k, raw)
+	var vv V
+	if m.val == nil {
+		vv, _ = raw.(V)
+		return kv, vv, iss
+	}
+	vv, err := m.val.Parse(scopeAtKey(ctx, k), raw)
+	if err != nil {
+		iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+	}
+	return kv, vv, iss
+}
+
+func (m *mapKVSchema[K, V]) Parse(ctx context.Context, v any) (map[K]V, error) {
+	switch src := v.(type) {
+	case map[K]V:
+		if err := m.ValidateValue(ctx, src); err != nil {
+			return nil, err
+		}
+		return m.finish(ctx, src)
+	case map[string]any:
+		iss := m.countIssues(len(src))
+		out := make(map[K]V, len(src))
+		for _, k := range sortedMapKeys(src) {
+			kv, vv, eiss := m.parseEntry(ctx, k, src[k])
+			if len(eiss) > 0 {
+				iss = goskema.AppendIssues(iss, eiss...)
+				if goskema.IsFailFast(ctx) {
+					break
+				}
+				continue
+			}
+			out[kv] = vv
+		}
+		if len(iss) > 0 {
+			return nil, iss
+		}
+		return m.finish(ctx, out)
+	}
+	return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected object"}}
+}
+
+func (m *mapKVSchema[K, V]) finish(ctx context.Context, out map[K]V) (map[K]V, error) {
+	nn, err := goskema.ApplyNormalize[map[K]V](ctx, out, m)
+	if err != nil {
+		return nil, err
+	}
+	if err := goskema.ApplyRefine[map[K]V](ctx, nn, m); err != nil {
+		return nil, err
+	}
+	return nn, nil
+}
+
+func (m *mapKVSchema[K, V]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[map[K]V], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (map[K]V, error) { return m.Parse(ctx, v) })
+}
+
+// ---- streaming SPI ----
+// ParseFromSource validates each key as soon as it is read, before decoding its value.
+// Depth, size and duplicate-key limits of opt apply to the whole object.
+func (m *mapKVSchema[K, V]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (map[K]V, error) {
+	engSrc := goskema.EngineTokenSource(src)
+	var collected []eng.SimpleIssue
+	dup := eng.DupIgnore
+	switch opt.Strictness.OnDuplicateKey {
+	case goskema.Error:
+		dup = eng.DupError
+	case goskema.Warn:
+		dup = eng.DupWarn
+	}
+	enforced := eng.WrapWithEnforcement(engSrc, eng.EnforceOptions{
+		OnDuplicate: dup,
+		MaxDepth:    opt.MaxDepth,
+		MaxBytes:    opt.MaxBytes,
+		IssueSink: func(si eng.SimpleIssue) {
+			collected = append(collected, si)
+		},
+		FailFast: opt.FailFast,
+	})
+
+	tok, err := enforced.NextToken()
+	if err != nil {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+	}
+	if tok.Kind != eng.KindBeginObject {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected object"}}
+	}
+	out := make(map[K]V)
+	var iss goskema.Issues
+	n := 0
+	for {
+		t, err := enforced.NextToken()
+		if err != nil {
+			return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		}
+		if t.Kind == eng.KindEndObject {
+			break
+		}
+		if t.Kind != eng.KindKey {
+			return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: "unexpected token in object"}}
+		}
+		k := t.String
+		n++
+		kv, kiss := m.parseKey(ctx, k)
+		sub := str.NewSubtreeSource(enforced)
+		var anyVal any
+		if src.NumberMode() == goskema.NumberFloat64 {
+			anyVal, err = eng.DecodeAnyFromSourceAsFloat64(sub)
+		} else {
+			anyVal, err = eng.DecodeAnyFromSource(sub)
+		}
+		if err != nil {
+			return nil, goskema.Issues{{Path: "/" + k, Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		}
+		if len(kiss) > 0 {
+			iss = goskema.AppendIssues(iss, kiss...)
+			if goskema.IsFailFast(ctx) {
+				return nil, iss
+			}
+			continue
+		}
+		reportEntry(ctx, k, anyVal)
+		var vv V
+		if m.val == nil {
+			vv, _ = anyVal.(V)
+		} else if vv, err = m.val.Parse(scopeAtKey(ctx, k), anyVal); err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+			if goskema.IsFailFast(ctx) {
+				return nil, iss
+			}
+			continue
+		}
+		out[kv] = vv
+	}
+	iss = goskema.AppendIssues(m.countIssues(n), iss...)
+	if len(collected) > 0 && !goskema.IsFailFast(ctx) {
+		for _, si := range collected {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: si.Path, Code: si.Code, Message: si.Message})
+		}
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return m.finish(ctx, out)
+}
+
+func (m *mapKVSchema[K, V]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[map[K]V], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (map[K]V, error) { return m.ParseFromSource(ctx, src, opt) })
+}
+
+func (m *mapKVSchema[K, V]) TypeCheck(ctx context.Context, v any) error {
+	switch v.(type) {
+	case map[K]V, map[string]any:
+		return nil
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected object"}}
+}
+
+func (m *mapKVSchema[K, V]) RuleCheck(ctx context.Context, v any) error {
+	switch src := v.(type) {
+	case map[K]V:
+		return m.ValidateValue(ctx, src)
+	case map[string]any:
+		iss := m.countIssues(len(src))
+		for _, k := range sortedMapKeys(src) {
+			_, kiss := m.parseKey(ctx, k)
+			iss = goskema.AppendIssues(iss, kiss...)
+		}
+		if len(iss) > 0 {
+			return iss
+		}
+	}
+	return nil
+}
+
+func (m *mapKVSchema[K, V]) Validate(ctx context.Context, v any) error {
+	if err := m.TypeCheck(ctx, v); err != nil {
+		return err
+	}
+	return m.RuleCheck(ctx, v)
+}
+
+func (m *mapKVSchema[K, V]) ValidateValue(ctx context.Context, v map[K]V) error {
+	iss := m.countIssues(len(v))
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		_, kiss := m.parseKey(ctx, k)
+		iss = goskema.AppendIssues(iss, kiss...)
+		if m.val == nil {
+			continue
+		}
+		if err := m.val.ValidateValue(ctx, v[K(k)]); err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+		}
+	}
+	if len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+
+func (m *mapKVSchema[K, V]) JSONSchema() (*js.Schema, error) {
+	return m.exportJSONSchema(&jsonExport{})
+}
+
+func (m *mapKVSchema[K, V]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	s := &js.Schema{Type: "object", AdditionalProperties: true}
+	if m.key != nil {
+		ks, err := exportJSONSchema(m.key, ex)
+		if err != nil {
+			return nil, err
+		}
+		s.PropertyNames = ks
+	}
+	if m.val != nil {
+		vs, err := exportJSONSchema(m.val, ex)
+		if err != nil {
+			return nil, err
+		}
+		s.AdditionalProperties = vs
+	}
+	if m.minProps >= 0 {
+		n := m.minProps
+		s.MinProperties = &n
+	}
+	if m.maxProps >= 0 {
+		n := m.maxProps
+		s.MaxProperties = &n
+	}
+	return s, nil
+}
+
+// elemSchema exposes the value schema for nested struct projection in Bind.
+func (m *mapKVSchema[K, V]) elemSchema() any { return m.val }
+
+// sortedMapKeys returns the keys of m in sorted order, for deterministic issue order.
+func sortedMapKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,111 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type region string
+
+type labelKey string
+
+func TestMapKV_EnumKeys(t *testing.T) {
+	s := g.MapKV(g.Enum[region]("tokyo", "osaka"), goskema.Schema[string](g.String().MinLen(1)))
+	m, err := s.Parse(context.Background(), map[string]any{"tokyo": "a", "osaka": "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m[region("tokyo")] != "a" {
+		t.Fatalf("unexpected map: %v", m)
+	}
+	_, err = s.Parse(context.Background(), map[string]any{"kyoto": "a", "osaka": ""})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 2 {
+		t.Fatalf("expected key and value issues, got %v", err)
+	}
+	if iss[0].Path != "/kyoto" || iss[0].Code != goskema.CodeInvalidEnum || iss[0].Params["key"] != "kyoto" {
+		t.Fatalf("unexpected key issue: %+v", iss[0])
+	}
+	if iss[1].Path != "/osaka" || iss[1].Code != goskema.CodeTooShort || iss[1].Params["key"] != nil {
+		t.Fatalf("unexpected value issue: %+v", iss[1])
+	}
+}
+
+func TestMapKV_PatternKeysAndCounts(t *testing.T) {
+	key := g.StringAs[labelKey](g.String().Pattern(regexp.MustCompile(`^[a-z]+$`)))
+	s := g.MapKV(key, goskema.Schema[string](g.String())).MinProps(1).MaxProps(2)
+	if _, err := s.Parse(context.Background(), map[string]any{}); err == nil {
+		t.Fatal("expected min properties error")
+	}
+	_, err := s.Parse(context.Background(), map[string]any{"a": "x", "b": "y", "c": "z"})
+	if iss, _ := goskema.AsIssues(err); len(iss) != 1 || iss[0].Code != goskema.CodeTooLong || iss[0].Path != "/" {
+		t.Fatalf("expected max properties error, got %v", err)
+	}
+	_, err = s.Parse(context.Background(), map[string]any{"Bad": "x"})
+	if iss, _ := goskema.AsIssues(err); len(iss) != 1 || iss[0].Path != "/Bad" || iss[0].Code != goskema.CodePattern {
+		t.Fatalf("expected key pattern error, got %v", err)
+	}
+	if err := s.ValidateValue(context.Background(), map[labelKey]string{"BAD": "x"}); err == nil {
+		t.Fatal("expected ValidateValue to check keys")
+	}
+}
+
+func TestMapKV_Streaming(t *testing.T) {
+	s := g.MapKV(g.Enum[region]("tokyo"), goskema.Schema[json.Number](g.NumberJSON())).MaxProps(3)
+	m, err := goskema.ParseFrom(context.Background(), s, goskema.JSONBytes([]byte(`{"tokyo":1}`)))
+	if err != nil || m["tokyo"] != "1" {
+		t.Fatalf("unexpected result %v %v", m, err)
+	}
+	_, err = goskema.ParseFrom(context.Background(), s, goskema.JSONBytes([]byte(`{"tokyo":1,"paris":2}`)))
+	if iss, _ := goskema.AsIssues(err); len(iss) != 1 || iss[0].Path != "/paris" {
+		t.Fatalf("expected key issue at /paris, got %v", err)
+	}
+}
+
+func TestMapKV_InObjectAndBind(t *testing.T) {
+	type quota struct {
+		Limits map[region]json.Number `json:"limits"`
+	}
+	s := g.ObjectOf[quota]().
+		Field("limits", g.SchemaOf(g.MapKV(g.Enum[region]("tokyo", "osaka"), goskema.Schema[json.Number](g.NumberJSON())).MaxProps(1))).Required().
+		MustBind()
+	q, err := s.Parse(context.Background(), map[string]any{"limits": map[string]any{"tokyo": json.Number("3")}})
+	if err != nil || q.Limits["tokyo"] != "3" {
+		t.Fatalf("unexpected result %+v %v", q, err)
+	}
+	_, err = s.Parse(context.Background(), map[string]any{"limits": map[string]any{"nagoya": json.Number("3")}})
+	if iss, _ := goskema.AsIssues(err); len(iss) == 0 || iss[0].Path != "/limits/nagoya" {
+		t.Fatalf("expected issue at /limits/nagoya, got %v", err)
+	}
+}
+
+func TestMapKV_JSONSchema(t *testing.T) {
+	s := g.MapKV(g.Enum[region]("tokyo"), goskema.Schema[string](g.String())).MinProps(1).MaxProps(5)
+	js, err := s.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(js)
+	want := `{"type":"object","additionalProperties":{"type":"string"},"minProperties":1,"maxProperties":5,"propertyNames":{"type":"string","enum":["tokyo"]}}`
+	if !strings.Contains(string(b), `"propertyNames"`) || string(b) != want {
+		t.Fatalf("unexpected schema: %s", b)
+	}
+}
+
+func TestMapKV_Streaming_HonorsMaxDepth(t *testing.T) {
+	ctx := context.Background()
+	s := g.MapKV[string, any](goskema.Schema[string](g.String()), nil)
+	deep := strings.Repeat(`{"a":`, 10) + `1` + strings.Repeat(`}`, 10)
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 5}); err == nil {
+		t.Fatalf("expected max depth error")
+	}
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 20}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package dsl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// numberBounds holds range constraints shared by NumberJSON and the *Of numeric adapters.
+// Bounds are kept as exact rationals so that json.Number text is compared without float rounding.
+type numberBounds struct {
+	min          *big.Rat
+	minText      json.Number
+	exclusiveMin bool
+	max          *big.Rat
+	maxText      json.Number
+	exclusiveMax bool
+	multipleOf   *big.Rat
+	multipleText json.Number
+	// invalid records the first malformed bound; validation fails closed with it.
+	invalid error
+}
+
+func (b *numberBounds) empty() bool {
+	return b.min == nil && b.max == nil && b.multipleOf == nil && b.invalid == nil
+}
+
+func (b *numberBounds) parseBound(v json.Number) *big.Rat {
+	r, ok := new(big.Rat).SetString(string(v))
+	if !ok {
+		if b.invalid == nil {
+			b.invalid = errors.New("invalid numeric bound: " + string(v))
+		}
+		return nil
+	}
+	return r
+}
+
+func (b *numberBounds) setMin(v json.Number, exclusive bool) {
+	if r := b.parseBound(v); r != nil {
+		b.min, b.minText, b.exclusiveMin = r, v, exclusive
+	}
+}
+
+func (b *numberBounds) setMax(v json.Number, exclusive bool) {
+	if r := b.parseBound(v); r != nil {
+		b.max, b.maxText, b.exclusiveMax = r, v, exclusive
+	}
+}
+
+func (b *numberBounds) setMultipleOf(v json.Number) {
+	r := b.parseBound(v)
+	if r == nil {
+		return
+	}
+	if r.Sign() <= 0 {
+		if b.invalid == nil {
+			b.invalid = errors.New("multipleOf must be positive: " + string(v))
+		}
+		return
+	}
+	b.multipleOf, b.multipleText = r, v
+}
+
+// buildIssues reports the first malformed bound, if any.
+func (b *numberBounds) buildIssues() error {
+	if b.invalid == nil {
+		return nil
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: b.invalid.Error(), Cause: b.invalid}}
+}
+
+// check evaluates the bounds against the textual number. In fail-fast mode it stops at the first issue.
+func (b *numberBounds) check(ctx context.Context, text string) goskema.Issues {
+	if b.empty() {
+		return nil
+	}
+	if b.invalid != nil {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: b.invalid.Error(), Cause: b.invalid}}
+	}
+	got, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "not a finite number"}}
+	}
+	var iss goskema.Issues
+	if b.min != nil {
+		c := got.Cmp(b.min)
+		if c < 0 || (c == 0 && b.exclusiveMin) {
+			params := map[string]any{"min": b.minText, "got": json.Number(text)}
+			hint := "must be >= " + string(b.minText)
+			if b.exclusiveMin {
+				params["exclusive"] = true
+				hint = "must be > " + string(b.minText)
+			}
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/", Code: goskema.CodeTooSmall, Message: i18n.T(goskema.CodeTooSmall, nil), Hint: hint, Params: params})
+			if goskema.IsFailFast(ctx) {
+				return iss
+			}
+		}
+	}
+	if b.max != nil {
+		c := got.Cmp(b.max)
+		if c > 0 || (c == 0 && b.exclusiveMax) {
+			params := map[string]any{"max": b.maxText, "got": json.Number(text)}
+			hint := "must be <= " + string(b.maxText)
+			if b.exclusiveMax {
+				params["exclusive"] = true
+				hint = "must be < " + string(b.maxText)
+			}
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/", Code: goskema.CodeTooBig, Message: i18n.T(goskema.CodeTooBig, nil), Hint: hint, Params: params})
+			if goskema.IsFailFast(ctx) {
+				return iss
+			}
+		}
+	}
+	if b.multipleOf != nil {
+		if !new(big.Rat).Quo(got, b.multipleOf).IsInt() {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/", Code: goskema.CodeNotMultipleOf, Message: i18n.T(goskema.CodeNotMultipleOf, nil), Hint: "must be a multiple of " + string(b.multipleText), Params: map[string]any{"multipleOf": b.multipleText, "got": json.Number(text)}})
+		}
+	}
+	return iss
+}
+
+// apply projects the bounds into JSON Schema keywords.
+func (b *numberBounds) apply(s *js.Schema) *js.Schema {
+	if b.min != nil {
+		f, _ := b.min.Float64()
+		if b.exclusiveMin {
+			s.ExclusiveMinimum = ptrFloat(f)
+		} else {
+			s.Minimum = ptrFloat(f)
+		}
+	}
+	if b.max != nil {
+		f, _ := b.max.Float64()
+		if b.exclusiveMax {
+			s.ExclusiveMaximum = ptrFloat(f)
+		} else {
+			s.Maximum = ptrFloat(f)
+		}
+	}
+	if b.multipleOf != nil {
+		f, _ := b.multipleOf.Float64()
+		s.MultipleOf = ptrFloat(f)
+	}
+	return s
+}
+
+// ---- NumberBuilder range options ----
+
+// Min sets an inclusive lower bound.
+func (n *numberJSONSchema) Min(v json.Number) NumberBuilder { n.bounds.setMin(v, false); return n }
+
+// Max sets an inclusive upper bound.
+func (n *numberJSONSchema) Max(v json.Number) NumberBuilder { n.bounds.setMax(v, false); return n }
+
+// ExclusiveMin sets an exclusive lower bound.
+func (n *numberJSONSchema) ExclusiveMin(v json.Number) NumberBuilder {
+	n.bounds.setMin(v, true)
+	return n
+}
+
+// ExclusiveMax sets an exclusive upper bound.
+func (n *numberJSONSchema) ExclusiveMax(v json.Number) NumberBuilder {
+	n.bounds.setMax(v, true)
+	return n
+}
+
+// MultipleOf requires the value to be an integer multiple of v (v > 0).
+func (n *numberJSONSchema) MultipleOf(v json.Number) NumberBuilder {
+	n.bounds.setMultipleOf(v)
+	return n
+}
+
+// Positive is shorthand for ExclusiveMin("0").
+func (n *numberJSONSchema) Positive() NumberBuilder { return n.ExclusiveMin("0") }
+
+// NonNegative is shorthand for Min("0").
+func (n *numberJSONSchema) NonNegative() NumberBuilder { return n.Min("0") }
+
+// checkBuild reports malformed bounds (e.g. Min("abc"), MultipleOf("0")) at Build.
+func (n numberJSONSchema) checkBuild() error { return n.bounds.buildIssues() }
+
+// numberSchemaFrom unwraps a NumberBuilder into the concrete schema value used by typed adapters.
+func numberSchemaFrom(nb NumberBuilder) numberJSONSchema {
+	if n, ok := nb.(*numberJSONSchema); ok && n != nil {
+		return *n
+	}
+	return numberJSONSchema{}
+}
+
+// validatedNumber runs ValidateValue on values produced by the direct Go-number fast paths
+// (used for default application) so that bounds apply there as well.
+func validatedNumber[T any](ctx context.Context, s goskema.Schema[T], v T) (T, error) {
+	if err := s.ValidateValue(ctx, v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+func intText(i int64) string   { return strconv.FormatInt(i, 10) }
+func uintText(u uint64) string { return strconv.FormatUint(u, 10) }
+
+// ---- *OfSchema: constrained numeric adapters ----
+
+// IntOfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~int).
+// Example: Field("qty", IntOfSchema[int](NumberJSON().Min("1").Max("100")))
+func IntOfSchema[T ~int](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](intAsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// FloatOfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~float64).
+func FloatOfSchema[T ~float64](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](floatAsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// UintOfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~uint64).
+func UintOfSchema[T ~uint64](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](uintAsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// NumberOfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~string).
+func NumberOfSchema[T ~string](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](numberAsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Int64OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~int64).
+func Int64OfSchema[T ~int64](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](int64AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Int32OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~int32).
+func Int32OfSchema[T ~int32](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](int32AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Uint32OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~uint32).
+func Uint32OfSchema[T ~uint32](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](uint32AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Int16OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~int16).
+func Int16OfSchema[T ~int16](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](int16AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Uint16OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~uint16).
+func Uint16OfSchema[T ~uint16](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](uint16AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Int8OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~int8).
+func Int8OfSchema[T ~int8](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](int8AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
+
+// Uint8OfSchema converts a constrained NumberBuilder into an AnyAdapter projected to T(~uint8).
+func Uint8OfSchema[T ~uint8](nb NumberBuilder) AnyAdapter {
+	n := numberSchemaFrom(nb)
+	ad := anyAdapterFromSchema[T](uint8AsSchema[T]{n: n})
+	ad.orig = n
+	return ad
+}
@@ -0,0 +1,110 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestNumber_MinMax_Exclusive(t *testing.T) {
+	ctx := context.Background()
+	n := g.NumberJSON().Min("1").ExclusiveMax("10")
+
+	if _, err := n.Parse(ctx, json.Number("1")); err != nil {
+		t.Fatalf("1 should pass: %v", err)
+	}
+	_, err := n.Parse(ctx, json.Number("0.5"))
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Code != goskema.CodeTooSmall || iss[0].Params["min"] != json.Number("1") || iss[0].Params["got"] != json.Number("0.5") {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+	if code := firstCode(t, n.ValidateValue(ctx, "10")); code != goskema.CodeTooBig {
+		t.Fatalf("want too_big, got %s", code)
+	}
+	if code := firstCode(t, g.NumberJSON().Positive().Validate(ctx, json.Number("0"))); code != goskema.CodeTooSmall {
+		t.Fatalf("want too_small, got %s", code)
+	}
+	if err := g.NumberJSON().NonNegative().Validate(ctx, json.Number("0")); err != nil {
+		t.Fatalf("0 should be non-negative: %v", err)
+	}
+}
+
+func TestNumber_ExactComparison(t *testing.T) {
+	ctx := context.Background()
+	// 2^53+1 is not representable as float64; the bound must still be exact.
+	n := g.NumberJSON().Max("9007199254740992")
+	if code := firstCode(t, n.ValidateValue(ctx, "9007199254740993")); code != goskema.CodeTooBig {
+		t.Fatalf("want too_big, got %s", code)
+	}
+
+	cents := g.NumberJSON().MultipleOf("0.01")
+	if err := cents.ValidateValue(ctx, "19.99"); err != nil {
+		t.Fatalf("19.99 should be a multiple of 0.01: %v", err)
+	}
+	if code := firstCode(t, cents.ValidateValue(ctx, "0.305")); code != goskema.CodeNotMultipleOf {
+		t.Fatalf("want not_multiple_of, got %s", code)
+	}
+}
+
+func TestNumber_TypedAdapters(t *testing.T) {
+	ctx := context.Background()
+	type Item struct {
+		Qty int `json:"qty"`
+	}
+	item := g.ObjectOf[Item]().
+		Field("qty", g.IntOfSchema[int](g.NumberJSON().Min("1").Max("100"))).Default(0).
+		UnknownStrict().
+		MustBind()
+
+	if v, err := item.Parse(ctx, map[string]any{"qty": json.Number("5")}); err != nil || v.Qty != 5 {
+		t.Fatalf("unexpected: %+v %v", v, err)
+	}
+	// The default goes through the direct Go-int fast path and must still honor bounds.
+	_, err := item.Parse(ctx, map[string]any{})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/qty" || iss[0].Code != goskema.CodeTooSmall {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	_, err = goskema.ParseFrom(ctx, item, goskema.JSONBytes([]byte(`{"qty":101}`)))
+	iss, _ = goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/qty" || iss[0].Code != goskema.CodeTooBig {
+		t.Fatalf("unexpected streaming issues: %+v", iss)
+	}
+}
+
+func TestNumber_JSONSchema_Bounds(t *testing.T) {
+	s, err := g.NumberJSON().Min("0").ExclusiveMax("1.5").MultipleOf("0.25").JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema err: %v", err)
+	}
+	got := normalize(s)
+	want := normalize(map[string]any{
+		"type":             "number",
+		"minimum":          0,
+		"exclusiveMaximum": 1.5,
+		"multipleOf":       0.25,
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+}
+
+func TestNumber_MalformedBoundsFailBuild(t *testing.T) {
+	cases := map[string]g.AnyAdapter{
+		"min":        g.IntOfSchema[int](g.NumberJSON().Min("abc")),
+		"multipleOf": g.SchemaOf[json.Number](g.NumberJSON().MultipleOf("0")),
+		"elements":   g.ArrayOf[json.Number](g.NumberJSON().Max("1e")),
+	}
+	for name, ad := range cases {
+		_, err := g.Object().Field("n", ad).Build()
+		iss, ok := goskema.AsIssues(err)
+		if !ok || len(iss) != 1 || iss[0].Path != "/n" || iss[0].Code != goskema.CodeParseError {
+			t.Errorf("%s: expected build error at /n, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package dsl
+
+import (
+	"context"
+	"reflect"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// fieldAccess is a bit set of field access modes keyed on the request operation
+// (goskema.WithRequest).
+type fieldAccess uint8
+
+const (
+	accessReadOnly fieldAccess = 1 << iota
+	accessWriteOnly
+	accessImmutable
+)
+
+// ReadOnly marks the current field as server-owned (ids, status, timestamps). On Create/Update/
+// Patch input (goskema.WithRequest) the field is not part of the input: under UnknownStrict it is
+// rejected as unknown_key, otherwise it is dropped; its default and required flag do not apply.
+// Without a request the field parses normally, so stored documents and responses keep it.
+// JSON Schema: readOnly.
+func (f *fieldStep) ReadOnly() *fieldStep {
+	f.b.setAccess(f.name, accessReadOnly)
+	return f
+}
+
+// WriteOnly marks the current field as input-only (passwords, secrets): it is accepted on input
+// and records PresenceWriteOnly, so EncodePreservingObject removes it. JSON Schema: writeOnly.
+func (f *fieldStep) WriteOnly() *fieldStep {
+	f.b.setAccess(f.name, accessWriteOnly)
+	return f
+}
+
+// Immutable rejects Update/Patch input that changes the field compared with RequestInfo.Old
+// (reflect.DeepEqual on the parsed value) with conflict at the field's pointer. Omitting the
+// field is not a change; setting it when Old has no value is allowed.
+func (f *fieldStep) Immutable() *fieldStep {
+	f.b.setAccess(f.name, accessImmutable)
+	return f
+}
+
+// ReadOnly marks the current field as server-owned (see fieldStep.ReadOnly).
+func (f *fieldStepT[T]) ReadOnly() *fieldStepT[T] {
+	f.tb.inner.setAccess(f.name, accessReadOnly)
+	return f
+}
+
+// WriteOnly marks the current field as input-only (see fieldStep.WriteOnly).
+func (f *fieldStepT[T]) WriteOnly() *fieldStepT[T] {
+	f.tb.inner.setAccess(f.name, accessWriteOnly)
+	return f
+}
+
+// Immutable rejects changes against RequestInfo.Old (see fieldStep.Immutable).
+func (f *fieldStepT[T]) Immutable() *fieldStepT[T] {
+	f.tb.inner.setAccess(f.name, accessImmutable)
+	return f
+}
+
+func (b *objectBuilder) setAccess(field string, a fieldAccess) {
+	ad := b.fields[field]
+	ad.access |= a
+	b.fields[field] = ad
+}
+
+// isInputOp reports whether ctx parses request input (Create/Update/Patch).
+func isInputOp(ctx context.Context) bool {
+	op, ok := goskema.RequestOp(ctx)
+	return ok && (op == goskema.OpCreate || op == goskema.OpUpdate || op == goskema.OpPatch)
+}
+
+// skipsField reports whether a known field is left out of parsing for this request.
+func skipsField(ctx context.Context, ad AnyAdapter) bool {
+	return ad.access&accessReadOnly != 0 && isInputOp(ctx)
+}
+
+// readOnlyInput handles read-only fields given on request input: rejected under UnknownStrict,
+// dropped otherwise. src is copied only when a field is dropped.
+func (o *objectSchema) readOnlyInput(ctx context.Context, src map[string]any) (map[string]any, goskema.Issues) {
+	if !isInputOp(ctx) {
+		return src, nil
+	}
+	var iss goskema.Issues
+	copied := false
+	for _, k := range o.sortedKnownKeys() {
+		if _, ok := src[k]; !ok || o.fields[k].access&accessReadOnly == 0 {
+			continue
+		}
+		if o.unknownPolicy == goskema.UnknownStrict {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeUnknownKey, Message: i18n.T(goskema.CodeUnknownKey, nil), Hint: "read-only field", Params: map[string]any{"readOnly": true}})
+			continue
+		}
+		if !copied {
+			cp := make(map[string]any, len(src))
+			for sk, sv := range src {
+				cp[sk] = sv
+			}
+			src, copied = cp, true
+		}
+		delete(src, k)
+	}
+	return src, iss
+}
+
+// immutableIssues reports immutable fields whose parsed value differs from the previous value
+// on Update/Patch.
+func (o *objectSchema) immutableIssues(ctx context.Context, out map[string]any) goskema.Issues {
+	op, ok := goskema.RequestOp(ctx)
+	if !ok || (op != goskema.OpUpdate && op != goskema.OpPatch) {
+		return nil
+	}
+	old, _ := goskema.RequestOld(ctx).(map[string]any)
+	if old == nil {
+		return nil
+	}
+	var iss goskema.Issues
+	for _, k := range o.sortedKnownKeys() {
+		if o.fields[k].access&accessImmutable == 0 {
+			continue
+		}
+		nv, given := out[k]
+		ov, had := old[k]
+		if given && had && !reflect.DeepEqual(nv, ov) {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: "immutable field cannot be changed", Params: map[string]any{"field": k}})
+		}
+	}
+	return iss
+}
+
+// childRequestCtx narrows the previous value to field k for object children, so that nested
+// objects compare their immutable fields against the right value; other children see none.
+func childRequestCtx(ctx context.Context, k string, ad AnyAdapter) context.Context {
+	if _, ok := goskema.RequestOp(ctx); !ok {
+		return ctx
+	}
+	var old any
+	if _, ok := ad.orig.(interface{ requestScoped() }); ok {
+		if m, ok := goskema.RequestOld(ctx).(map[string]any); ok {
+			old = m[k]
+		}
+	}
+	return goskema.WithRequestOld(ctx, old)
+}
+
+func (o *objectSchema) requestScoped() {}
+
+// accessSchema annotates a property schema with readOnly/writeOnly.
+func accessSchema(ps *js.Schema, a fieldAccess) *js.Schema {
+	if a&(accessReadOnly|accessWriteOnly) == 0 {
+		return ps
+	}
+	cp := *ps
+	cp.ReadOnly = a&accessReadOnly != 0
+	cp.WriteOnly = a&accessWriteOnly != 0
+	return &cp
+}
@@ -0,0 +1,179 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+type account struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Tenant   string `json:"tenant"`
+}
+
+func accountSchema(strict bool) goskema.Schema[account] {
+	b := g.ObjectOf[account]().
+		Field("id", g.StringOf[string]()).ReadOnly().Required().
+		Field("email", g.StringOf[string]()).Required().
+		Field("password", g.StringOf[string]()).WriteOnly().
+		Field("tenant", g.StringOf[string]()).Immutable()
+	if !strict {
+		b.UnknownStrip()
+	}
+	return b.MustBind()
+}
+
+func TestAccess_ReadOnlyOnInput(t *testing.T) {
+	create := goskema.WithRequest(context.Background(), goskema.RequestInfo[account]{Op: goskema.OpCreate})
+
+	// required read-only field is not expected on input
+	v, err := accountSchema(true).Parse(create, map[string]any{"email": "a@example.com"})
+	if err != nil || v.Email != "a@example.com" {
+		t.Fatalf("unexpected result: %+v %v", v, err)
+	}
+
+	_, err = accountSchema(true).Parse(create, map[string]any{"id": "x", "email": "a@example.com"})
+	if iss, ok := goskema.AsIssues(err); !ok || len(iss) != 1 || iss[0].Path != "/id" || iss[0].Code != goskema.CodeUnknownKey {
+		t.Fatalf("expected unknown_key at /id, got %v", err)
+	}
+
+	v, err = accountSchema(false).Parse(create, map[string]any{"id": "x", "email": "a@example.com"})
+	if err != nil || v.ID != "" {
+		t.Fatalf("read-only field should be stripped: %+v %v", v, err)
+	}
+
+	// without a request (stored documents, responses) the field is parsed and required
+	v, err = accountSchema(true).Parse(context.Background(), map[string]any{"id": "x", "email": "a@example.com"})
+	if err != nil || v.ID != "x" {
+		t.Fatalf("unexpected result: %+v %v", v, err)
+	}
+}
+
+func TestAccess_WriteOnlyDroppedOnEncode(t *testing.T) {
+	s := g.Object().
+		Field("email", g.StringOf[string]()).
+		Field("password", g.StringOf[string]()).WriteOnly().
+		MustBuild()
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"email":"a","password":"secret"}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Value["password"] != "secret" || dm.Presence["/password"]&goskema.PresenceWriteOnly == 0 {
+		t.Fatalf("write-only field should be accepted: %v %v", dm.Value, dm.Presence)
+	}
+	out := goskema.EncodePreservingObject(dm)
+	if _, ok := out["password"]; ok || out["email"] != "a" {
+		t.Fatalf("unexpected encode: %v", out)
+	}
+}
+
+func TestAccess_Immutable(t *testing.T) {
+	s := accountSchema(false)
+	old := account{ID: "1", Email: "a@example.com", Tenant: "acme"}
+	update := goskema.WithRequest(context.Background(), goskema.RequestInfo[account]{Op: goskema.OpUpdate, Old: &old})
+
+	if _, err := s.Parse(update, map[string]any{"email": "b@example.com", "tenant": "acme"}); err != nil {
+		t.Fatalf("unchanged immutable field should pass: %v", err)
+	}
+	_, err := s.Parse(update, map[string]any{"email": "b@example.com", "tenant": "other"})
+	if iss, ok := goskema.AsIssues(err); !ok || len(iss) != 1 || iss[0].Path != "/tenant" || iss[0].Code != goskema.CodeConflict {
+		t.Fatalf("expected conflict at /tenant, got %v", err)
+	}
+	// create may set it freely
+	create := goskema.WithRequest(context.Background(), goskema.RequestInfo[account]{Op: goskema.OpCreate, Old: &old})
+	if _, err := s.Parse(create, map[string]any{"email": "b@example.com", "tenant": "other"}); err != nil {
+		t.Fatalf("unexpected err on create: %v", err)
+	}
+}
+
+func TestAccess_NestedImmutableAndTypedRules(t *testing.T) {
+	spec := g.Object().
+		Field("region", g.StringOf[string]()).Immutable().
+		Field("size", g.StringOf[string]()).
+		UnknownStrip().
+		MustBuild()
+	type cluster struct {
+		Spec map[string]any `json:"spec"`
+	}
+	var seen goskema.Operation = 99
+	s := g.ObjectOf[cluster]().
+		Field("spec", g.SchemaOf[map[string]any](spec)).Require("spec").
+		RefineT("op", func(dc goskema.DomainCtx[cluster], c cluster) []goskema.Issue {
+			seen = dc.Req.Op
+			if dc.Req.Old == nil {
+				return []goskema.Issue{{Path: "/", Code: goskema.CodeBusinessRule, Message: "old missing"}}
+			}
+			return nil
+		}).
+		MustBind()
+	old := cluster{Spec: map[string]any{"region": "eu", "size": "s"}}
+	ctx := goskema.WithRequest(context.Background(), goskema.RequestInfo[cluster]{Op: goskema.OpPatch, Old: &old})
+	if _, err := s.Parse(ctx, map[string]any{"spec": map[string]any{"region": "eu", "size": "l"}}); err != nil || seen != goskema.OpPatch {
+		t.Fatalf("unexpected result: %v (op %v)", err, seen)
+	}
+	_, err := s.Parse(ctx, map[string]any{"spec": map[string]any{"region": "us"}})
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/spec/region" || iss[0].Code != goskema.CodeConflict {
+		t.Fatalf("expected conflict at /spec/region, got %v", err)
+	}
+}
+
+func TestAccess_JSONSchema(t *testing.T) {
+	sch, err := accountSchema(true).JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(sch)
+	out := string(b)
+	if !strings.Contains(out, `"id":{"type":"string","readOnly":true}`) || !strings.Contains(out, `"password":{"type":"string","writeOnly":true}`) {
+		t.Fatalf("unexpected schema: %s", out)
+	}
+}
+
+func TestAccess_AllOf(t *testing.T) {
+	ctx := context.Background()
+	create := goskema.WithRequest(ctx, goskema.RequestInfo[map[string]any]{Op: goskema.OpCreate})
+	a := g.Object().
+		Field("id", g.StringOf[string]()).ReadOnly().
+		Field("pw", g.StringOf[string]()).WriteOnly().
+		Field("name", g.StringOf[string]()).
+		UnknownStrict().
+		MustBuild()
+	b := g.Object().
+		Field("id", g.StringOf[string]()).                     // identical definition
+		Field("pw", g.SchemaOf[string](g.String().MinLen(4))). // merged definition
+		Field("name", g.StringOf[string]()).ReadOnly().        // access from the second part
+		MustBuild()
+	s := g.AllOf(a, b).MustBuild()
+
+	_, err := s.Parse(create, map[string]any{"id": "x", "name": "n", "pw": "secret"})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 2 || iss[0].Path != "/id" || iss[1].Path != "/name" || iss[0].Code != goskema.CodeUnknownKey {
+		t.Fatalf("expected read-only id and name to be rejected, got %v", err)
+	}
+
+	dm, err := goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"id":"x","name":"n","pw":"secret"}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if out := goskema.EncodePreservingObject(dm); out["pw"] != nil {
+		t.Fatalf("write-only field kept by preserving encode: %#v", out)
+	}
+	if m, err := goskema.EncodeToMap(ctx, s, dm.Value); err != nil || m["pw"] != nil {
+		t.Fatalf("write-only field kept by EncodeToMap: %#v %v", m, err)
+	}
+
+	sch, err := s.JSONSchema()
+	if err != nil {
+		t.Fatalf("json schema: %v", err)
+	}
+	if !sch.Properties["id"].ReadOnly || !sch.Properties["name"].ReadOnly || !sch.Properties["pw"].WriteOnly {
+		raw, _ := json.Marshal(sch)
+		t.Fatalf("access modes missing from JSON Schema: %s", raw)
+	}
+}
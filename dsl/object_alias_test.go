@@ -0,0 +1,137 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestObject_Alias_ParseAndWarn(t *testing.T) {
+	s := g.Object().
+		Field("username", g.StringOf[string]()).Alias("userName").Required().
+		UnknownStrict().
+		MustBuild()
+
+	ctx, warns := goskema.CollectWarnings(context.Background())
+	v, err := s.Parse(ctx, map[string]any{"userName": "alice"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if v["username"] != "alice" {
+		t.Fatalf("alias not mapped to canonical field: %v", v)
+	}
+	if _, ok := v["userName"]; ok {
+		t.Fatalf("alias key should not remain: %v", v)
+	}
+	if len(*warns) != 1 {
+		t.Fatalf("expected one warning, got %v", *warns)
+	}
+	w := (*warns)[0]
+	if w.Path != "/userName" || w.Code != goskema.CodeDeprecatedField || !w.IsWarning() || w.Params["field"] != "username" {
+		t.Fatalf("unexpected warning: %+v", w)
+	}
+
+	// canonical name: no warning
+	ctx, warns = goskema.CollectWarnings(context.Background())
+	if _, err := s.Parse(ctx, map[string]any{"username": "bob"}); err != nil || len(*warns) != 0 {
+		t.Fatalf("unexpected result: %v %v", err, *warns)
+	}
+}
+
+func TestObject_Alias_DuplicateSpellings(t *testing.T) {
+	s := g.Object().
+		Field("username", g.StringOf[string]()).Alias("userName", "user_name").
+		UnknownStrict().
+		MustBuild()
+	for _, in := range []map[string]any{
+		{"username": "a", "userName": "b"},
+		{"userName": "a", "user_name": "b"},
+	} {
+		_, err := s.Parse(context.Background(), in)
+		iss, ok := goskema.AsIssues(err)
+		if !ok || len(iss) != 1 || iss[0].Code != goskema.CodeDuplicateKey {
+			t.Fatalf("%v: expected one duplicate_key issue, got %v", in, err)
+		}
+	}
+}
+
+func TestObject_Alias_NestedWarningsInDecoded(t *testing.T) {
+	spec := g.Object().
+		Field("replicas", g.SchemaOf[json.Number](g.NumberJSON())).Alias("replicaCount").
+		UnknownStrict().
+		MustBuild()
+	s := g.Object().
+		Field("specs", g.ArrayOf[map[string]any](spec)).
+		UnknownStrict().
+		MustBuild()
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"specs":[{"replicas":1},{"replicaCount":2}]}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(dm.Warnings) != 1 || dm.Warnings[0].Path != "/specs/1/replicaCount" {
+		t.Fatalf("unexpected warnings: %v", dm.Warnings)
+	}
+}
+
+func TestObject_Alias_TypedAndDerived(t *testing.T) {
+	type user struct {
+		Username string `json:"username"`
+	}
+	s := g.ObjectOf[user]().
+		Field("username", g.StringOf[string]()).Alias("userName").Required().
+		MustBind()
+	u, err := s.Parse(context.Background(), map[string]any{"userName": "carol"})
+	if err != nil || u.Username != "carol" {
+		t.Fatalf("unexpected result: %+v %v", u, err)
+	}
+
+	base := g.Object().
+		Field("username", g.StringOf[string]()).Alias("userName").
+		Field("email", g.StringOf[string]()).
+		MustBuild()
+	picked := g.Derive(base).Pick("email").UnknownStrict().MustBuild()
+	if _, err := picked.Parse(context.Background(), map[string]any{"userName": "x"}); err == nil {
+		t.Fatal("expected unknown_key after the aliased field was dropped")
+	}
+}
+
+func TestObject_Alias_BuildConflicts(t *testing.T) {
+	_, err := g.Object().
+		Field("a", g.StringOf[string]()).Alias("b").
+		Field("b", g.StringOf[string]()).
+		Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/b" || iss[0].Code != goskema.CodeConflict {
+		t.Fatalf("expected conflict at /b, got %v", err)
+	}
+
+	_, err = g.Object().
+		Field("username", g.StringOf[string]()).Alias("name").
+		Field("displayName", g.StringOf[string]()).Alias("name").
+		Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/name" || iss[0].Code != goskema.CodeConflict {
+		t.Fatalf("expected conflict at /name for an alias of two fields, got %v", err)
+	}
+}
+
+func TestObject_Alias_JSONSchemaDeprecated(t *testing.T) {
+	s := g.Object().
+		Field("username", g.StringOf[string]()).Alias("userName").Required().
+		UnknownStrict().
+		MustBuild()
+	sch, err := s.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(sch)
+	out := string(b)
+	if !strings.Contains(out, `"userName":{"type":"string","deprecated":true}`) || !strings.Contains(out, `"username":{"type":"string"}`) {
+		t.Fatalf("unexpected schema: %s", out)
+	}
+	if !strings.Contains(out, `"required":["username"]`) {
+		t.Fatalf("alias must not be required: %s", out)
+	}
+}
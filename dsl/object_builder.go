@@ -17,7 +17,10 @@ type objectBuilder struct {
 	refines       []objRefine
 	discriminator string
 	variants      map[string]goskema.Schema[map[string]any]
-	typedRules    []any // holds typedRule[T] values; retyped at Bind[T]
+	typedRules    []any             // holds typedRule[T] values; retyped at Bind[T]
+	buildIssues   goskema.Issues    // deferred configuration errors (e.g. AllOf conflicts), reported by Build
+	aliases       map[string]string // deprecated wire name -> canonical field (Alias)
+	conds         []objCondition    // RequiredIf/DependentRequired/MutuallyExclusive/RequireOneOf
 }
 
 type fieldStep struct {
@@ -35,6 +38,7 @@ func Object() *objectBuilder {
 		discriminator: "",
 		variants:      nil,
 		typedRules:    nil,
+		aliases:       map[string]string{},
 	}
 }
 
@@ -56,6 +60,31 @@ func (f *fieldStep) Optional() *objectBuilder {
 	return f.b
 }
 
+// Alias accepts the given deprecated wire names for the current field. Input using an alias is
+// parsed as the canonical field and records a deprecated_field warning (see
+// goskema.CollectWarnings); setting more than one spelling is a duplicate_key issue.
+// JSON Schema lists aliases as properties marked deprecated.
+// Example: Field("username", g.StringOf[string]()).Alias("userName").Required()
+func (f *fieldStep) Alias(names ...string) *fieldStep {
+	f.b.addAliases(f.name, names)
+	return f
+}
+
+// addAliases registers names as aliases of field; a name already registered for another field
+// is a conflict reported by Build.
+func (b *objectBuilder) addAliases(field string, names []string) {
+	for _, n := range names {
+		if n == "" || n == field {
+			continue
+		}
+		if prev, ok := b.aliases[n]; ok && prev != field {
+			b.buildIssues = append(b.buildIssues, goskema.Issue{Path: "/" + n, Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: "alias " + n + " is registered for both " + prev + " and " + field, Params: map[string]any{"field": prev, "other": field}})
+			continue
+		}
+		b.aliases[n] = field
+	}
+}
+
 // Deprecated: Prefer Field(...).Required() for a single field.
 // Use the builder-level Require("a","b") for marking multiple fields at once.
 // This method remains for backward compatibility but will be removed in a future major release.
@@ -71,12 +100,13 @@ func (f *fieldStep) Default(v any) *objectBuilder {
 	ad := f.b.fields[f.name]
 	// Apply default by parsing via the field schema to leverage Normalize/Validate/Refine
 	ad.applyDefault = func(ctx context.Context) (any, error) { return ad.parse(ctx, v) }
+	ad.computedDefault = false
 	prev := ad.jsonSchema
-	ad.jsonSchema = func() (*js.Schema, error) {
+	ad.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
 		if prev == nil {
 			return &js.Schema{Default: v}, nil
 		}
-		s, err := prev()
+		s, err := prev(ex)
 		if err != nil {
 			return nil, err
 		}
@@ -92,6 +122,9 @@ func (f *fieldStep) Default(v any) *objectBuilder {
 func (f *fieldStep) Refine(name string, fn func(context.Context, map[string]any) error) *objectBuilder {
 	return f.b.Refine(name, fn)
 }
+func (f *fieldStep) RefineFields(name string, fields []string, fn func(context.Context, map[string]any) error) *objectBuilder {
+	return f.b.RefineFields(name, fields, fn)
+}
 func (f *fieldStep) Field(name string, ad AnyAdapter) *fieldStep    { return f.b.Field(name, ad) }
 func (f *fieldStep) Build() (goskema.Schema[map[string]any], error) { return f.b.Build() }
 func (f *fieldStep) MustBuild() goskema.Schema[map[string]any]      { return f.b.MustBuild() }
@@ -134,6 +167,16 @@ func (b *objectBuilder) Refine(name string, fn func(context.Context, map[string]
 	return b
 }
 
+// RefineFields is like Refine but declares the top-level fields the refine reads, so that
+// Build rejects it when a derivation (Pick/Omit) drops one of them.
+func (b *objectBuilder) RefineFields(name string, fields []string, fn func(context.Context, map[string]any) error) *objectBuilder {
+	if fn == nil {
+		return b
+	}
+	b.refines = append(b.refines, objRefine{name: name, fn: fn, fields: append([]string(nil), fields...)})
+	return b
+}
+
 // addTypedRuleOpaque appends a typed rule instance stored in an opaque form (any).
 // The value should be a typedRule[T] constructed by the typed builder.
 func (b *objectBuilder) addTypedRuleOpaque(rule any) {
@@ -176,6 +219,9 @@ func (b *objectBuilder) OneOf(vars ...UnionVariant) *objectBuilder {
 
 // Build validates the builder and returns a Schema.
 func (b *objectBuilder) Build() (goskema.Schema[map[string]any], error) {
+	if len(b.buildIssues) > 0 {
+		return nil, b.buildIssues
+	}
 	// If discriminator is configured, return a union schema
 	if b.discriminator != "" && len(b.variants) > 0 {
 		return &unionSchema{discriminator: b.discriminator, mapping: b.variants}, nil
@@ -191,13 +237,60 @@ func (b *objectBuilder) Build() (goskema.Schema[map[string]any], error) {
 			return nil, goskema.Issues{goskema.Issue{Path: "/" + b.unknownTarget, Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "unknown_target must be map[string]any"}}
 		}
 	}
+	if iss := b.danglingRuleRefs(); len(iss) > 0 {
+		return nil, iss
+	}
+	if iss := b.aliasConflicts(); len(iss) > 0 {
+		return nil, iss
+	}
+	if iss := b.conditionRefs(); len(iss) > 0 {
+		return nil, iss
+	}
 	// cache sorted keys for deterministic order without per-parse sorting
 	kfs := make([]string, 0, len(b.fields))
 	for k := range b.fields {
 		kfs = append(kfs, k)
 	}
 	sort.Strings(kfs)
-	return &objectSchema{fields: b.fields, required: b.required, unknownPolicy: b.unknownPolicy, unknownTarget: b.unknownTarget, refines: b.refines, typedRulesAny: b.typedRules, sortedKeys: kfs}, nil
+	// Verify field configuration that can only be checked at build time (e.g. unknown formats).
+	var biss goskema.Issues
+	for _, k := range kfs {
+		if err := checkAdapterBuild(b.fields[k]); err != nil {
+			biss = goskema.AppendIssues(biss, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+		}
+	}
+	conds, ciss := b.resolvedConditions()
+	biss = goskema.AppendIssues(biss, ciss...)
+	if len(biss) > 0 {
+		return nil, biss
+	}
+	return &objectSchema{fields: b.fields, required: b.required, unknownPolicy: b.unknownPolicy, unknownTarget: b.unknownTarget, refines: b.refines, typedRulesAny: b.typedRules, sortedKeys: kfs, aliases: b.aliases, sortedAliases: sortedAliasKeys(b.aliases), conds: conds}, nil
+}
+
+// aliasConflicts rejects aliases that shadow a declared field or point at a missing one.
+func (b *objectBuilder) aliasConflicts() goskema.Issues {
+	var iss goskema.Issues
+	for _, a := range sortedAliasKeys(b.aliases) {
+		field := b.aliases[a]
+		if _, ok := b.fields[a]; ok {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + a, Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: "alias of " + field + " is also a field", Params: map[string]any{"field": field}})
+		} else if _, ok := b.fields[field]; !ok {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + a, Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: "alias target " + field + " is not a field", Params: map[string]any{"field": field}})
+		}
+	}
+	return iss
+}
+
+func sortedAliasKeys(aliases map[string]string) []string {
+	if len(aliases) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(aliases))
+	for a := range aliases {
+		keys = append(keys, a)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // MustBuild is like Build but panics on error.
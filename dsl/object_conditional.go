@@ -0,0 +1,342 @@
+package dsl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+type condKind int
+
+const (
+	condRequiredIf condKind = iota
+	condDependentRequired
+	condMutuallyExclusive
+	condRequireOneOf
+)
+
+// objCondition is a presence constraint between fields declared on the object builder
+// (RequiredIf, DependentRequired, MutuallyExclusive, RequireOneOf).
+type objCondition struct {
+	kind   condKind
+	field  string   // RequiredIf: the conditionally required field; DependentRequired: the trigger
+	when   string   // RequiredIf: the field whose value is compared
+	equals any      // RequiredIf: the value that makes field required
+	match  any      // RequiredIf: equals parsed by the when field (set by Build)
+	fields []string // DependentRequired: the dependents; MutuallyExclusive/RequireOneOf: the group
+}
+
+// RequiredIf makes field required when the parsed value of when equals equals, itself parsed
+// by the when field (from its JSON form if needed), so RequiredIf("x", "amount", 1) matches a
+// NumberJSON amount of 1. Build fails when the when field rejects equals. A missing field is
+// reported as required at its own pointer. JSON Schema: if/then under allOf.
+// Example: RequiredIf("cardNumber", "method", "card")
+func (b *objectBuilder) RequiredIf(field, when string, equals any) *objectBuilder {
+	b.conds = append(b.conds, objCondition{kind: condRequiredIf, field: field, when: when, equals: equals})
+	return b
+}
+
+// DependentRequired makes deps required whenever field is present.
+// JSON Schema: dependentRequired.
+func (b *objectBuilder) DependentRequired(field string, deps ...string) *objectBuilder {
+	b.conds = append(b.conds, objCondition{kind: condDependentRequired, field: field, fields: append([]string(nil), deps...)})
+	return b
+}
+
+// MutuallyExclusive allows at most one of fields to be present; every field after the first
+// present one is reported as conflict. JSON Schema: not/anyOf of the pairs.
+func (b *objectBuilder) MutuallyExclusive(fields ...string) *objectBuilder {
+	b.conds = append(b.conds, objCondition{kind: condMutuallyExclusive, fields: append([]string(nil), fields...)})
+	return b
+}
+
+// RequireOneOf requires exactly one of fields: none present reports required at each of them,
+// more than one reports conflict as MutuallyExclusive does. JSON Schema: oneOf of required.
+// Example: RequireOneOf("email", "phone")
+func (b *objectBuilder) RequireOneOf(fields ...string) *objectBuilder {
+	b.conds = append(b.conds, objCondition{kind: condRequireOneOf, fields: append([]string(nil), fields...)})
+	return b
+}
+
+// RequiredIf makes the current field required when when equals equals (see objectBuilder.RequiredIf).
+func (f *fieldStep) RequiredIf(when string, equals any) *objectBuilder {
+	return f.b.RequiredIf(f.name, when, equals)
+}
+
+// DependentRequired makes deps required whenever the current field is present.
+func (f *fieldStep) DependentRequired(deps ...string) *objectBuilder {
+	return f.b.DependentRequired(f.name, deps...)
+}
+
+// MutuallyExclusive allows at most one of the current field and others to be present.
+func (f *fieldStep) MutuallyExclusive(others ...string) *objectBuilder {
+	return f.b.MutuallyExclusive(append([]string{f.name}, others...)...)
+}
+
+// RequireOneOf requires exactly one of the current field and others.
+func (f *fieldStep) RequireOneOf(others ...string) *objectBuilder {
+	return f.b.RequireOneOf(append([]string{f.name}, others...)...)
+}
+
+// RequiredIf makes field required when when equals equals (see objectBuilder.RequiredIf).
+func (tb *objectBuilderT[T]) RequiredIf(field, when string, equals any) *objectBuilderT[T] {
+	tb.inner.RequiredIf(field, when, equals)
+	return tb
+}
+
+// DependentRequired makes deps required whenever field is present (see objectBuilder).
+func (tb *objectBuilderT[T]) DependentRequired(field string, deps ...string) *objectBuilderT[T] {
+	tb.inner.DependentRequired(field, deps...)
+	return tb
+}
+
+// MutuallyExclusive allows at most one of fields to be present (see objectBuilder).
+func (tb *objectBuilderT[T]) MutuallyExclusive(fields ...string) *objectBuilderT[T] {
+	tb.inner.MutuallyExclusive(fields...)
+	return tb
+}
+
+// RequireOneOf requires exactly one of fields (see objectBuilder.RequireOneOf).
+func (tb *objectBuilderT[T]) RequireOneOf(fields ...string) *objectBuilderT[T] {
+	tb.inner.RequireOneOf(fields...)
+	return tb
+}
+
+// RequiredIf makes the current field required when when equals equals (see objectBuilder.RequiredIf).
+func (f *fieldStepT[T]) RequiredIf(when string, equals any) *objectBuilderT[T] {
+	return f.tb.RequiredIf(f.name, when, equals)
+}
+
+// DependentRequired makes deps required whenever the current field is present.
+func (f *fieldStepT[T]) DependentRequired(deps ...string) *objectBuilderT[T] {
+	return f.tb.DependentRequired(f.name, deps...)
+}
+
+// MutuallyExclusive allows at most one of the current field and others to be present.
+func (f *fieldStepT[T]) MutuallyExclusive(others ...string) *objectBuilderT[T] {
+	return f.tb.MutuallyExclusive(append([]string{f.name}, others...)...)
+}
+
+// RequireOneOf requires exactly one of the current field and others.
+func (f *fieldStepT[T]) RequireOneOf(others ...string) *objectBuilderT[T] {
+	return f.tb.RequireOneOf(append([]string{f.name}, others...)...)
+}
+
+// refs lists the fields a condition mentions.
+func (c objCondition) refs() []string {
+	switch c.kind {
+	case condRequiredIf:
+		return []string{c.field, c.when}
+	case condDependentRequired:
+		return append([]string{c.field}, c.fields...)
+	}
+	return c.fields
+}
+
+// without returns c with field k removed, or false when the condition no longer makes sense.
+func (c objCondition) without(k string) (objCondition, bool) {
+	switch c.kind {
+	case condRequiredIf:
+		return c, c.field != k && c.when != k
+	case condDependentRequired:
+		if c.field == k {
+			return c, false
+		}
+	}
+	kept := make([]string, 0, len(c.fields))
+	for _, f := range c.fields {
+		if f != k {
+			kept = append(kept, f)
+		}
+	}
+	c.fields = kept
+	if c.kind == condMutuallyExclusive {
+		return c, len(kept) > 1
+	}
+	return c, len(kept) > 0
+}
+
+// requiresPresence reports whether the condition makes fields required (dropped by Partial).
+func (c objCondition) requiresPresence() bool { return c.kind != condMutuallyExclusive }
+
+// conditionRefs reports conditions that reference fields missing from the builder.
+func (b *objectBuilder) conditionRefs() goskema.Issues {
+	var iss goskema.Issues
+	for _, c := range b.conds {
+		for _, f := range c.refs() {
+			if _, ok := b.fields[f]; !ok {
+				iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + f, Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "condition references missing field " + f, Params: map[string]any{"field": f}})
+			}
+		}
+	}
+	return iss
+}
+
+// resolvedConditions returns a copy of the conditions with each RequiredIf value parsed by its
+// when field, so that it compares equal to parsed input (e.g. 1 and json.Number("1")).
+func (b *objectBuilder) resolvedConditions() ([]objCondition, goskema.Issues) {
+	out := append([]objCondition(nil), b.conds...)
+	var iss goskema.Issues
+	for i, c := range out {
+		if c.kind != condRequiredIf {
+			continue
+		}
+		out[i].match = c.equals
+		ad := b.fields[c.when]
+		if ad.parse == nil {
+			continue
+		}
+		v, err := ad.parse(context.Background(), c.equals)
+		if err != nil {
+			// Go values such as 1 or region("tokyo") are parsed in their JSON form
+			if w, ok := jsonWireValue(c.equals); ok {
+				v, err = ad.parse(context.Background(), w)
+			}
+		}
+		if err != nil {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + c.when, Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: fmt.Sprintf("RequiredIf value %v is not accepted by field %s", c.equals, c.when), Params: map[string]any{"field": c.field, "when": c.when, "equals": c.equals}})
+			continue
+		}
+		out[i].match = v
+	}
+	return out, iss
+}
+
+// jsonWireValue returns v as decoded from its JSON encoding (numbers as json.Number).
+func jsonWireValue(v any) (any, bool) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var w any
+	if dec.Decode(&w) != nil {
+		return nil, false
+	}
+	return w, true
+}
+
+// conditionIssues evaluates the conditions. present reports whether a field was given (or
+// defaulted); value returns its parsed value.
+func (o *objectSchema) conditionIssues(present func(string) bool, value func(string) (any, bool)) goskema.Issues {
+	var iss goskema.Issues
+	required := func(k, hint string, params map[string]any) {
+		iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeRequired, Message: i18n.T(goskema.CodeRequired, nil), Hint: hint, Params: params})
+	}
+	exclusive := func(fields []string) int {
+		first, n := "", 0
+		for _, f := range fields {
+			if !present(f) {
+				continue
+			}
+			if n++; n == 1 {
+				first = f
+				continue
+			}
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + f, Code: goskema.CodeConflict, Message: i18n.T(goskema.CodeConflict, nil), Hint: "mutually exclusive with " + first, Params: map[string]any{"field": first, "fields": fields}})
+		}
+		return n
+	}
+	for _, c := range o.conds {
+		switch c.kind {
+		case condRequiredIf:
+			if v, ok := value(c.when); ok && reflect.DeepEqual(v, c.match) && !present(c.field) {
+				required(c.field, fmt.Sprintf("required when %s is %v", c.when, c.equals), map[string]any{"when": c.when, "equals": c.equals})
+			}
+		case condDependentRequired:
+			if !present(c.field) {
+				continue
+			}
+			for _, d := range c.fields {
+				if !present(d) {
+					required(d, "required by "+c.field, map[string]any{"field": c.field})
+				}
+			}
+		case condMutuallyExclusive:
+			exclusive(c.fields)
+		case condRequireOneOf:
+			if exclusive(c.fields) == 0 {
+				for _, f := range c.fields {
+					required(f, "one of "+strings.Join(c.fields, ", ")+" is required", map[string]any{"fields": c.fields})
+				}
+			}
+		}
+	}
+	return iss
+}
+
+// parsedConditionIssues evaluates the conditions during Parse: a field counts as present when
+// it was given in src (even if it failed to parse) or defaulted into out; values are the parsed ones.
+func (o *objectSchema) parsedConditionIssues(src, out map[string]any) goskema.Issues {
+	if len(o.conds) == 0 {
+		return nil
+	}
+	return o.conditionIssues(
+		func(k string) bool {
+			if _, ok := out[k]; ok {
+				return true
+			}
+			_, ok := src[k]
+			return ok
+		},
+		func(k string) (any, bool) { v, ok := out[k]; return v, ok },
+	)
+}
+
+// conditionIssuesIn evaluates the conditions against an object value (RuleCheck/ValidateValue).
+func (o *objectSchema) conditionIssuesIn(m map[string]any) goskema.Issues {
+	if len(o.conds) == 0 {
+		return nil
+	}
+	return o.conditionIssues(
+		func(k string) bool { _, ok := m[k]; return ok || o.setViaAlias(m, k) },
+		func(k string) (any, bool) { v, ok := m[k]; return v, ok },
+	)
+}
+
+// conditionSchemas exports the conditions as JSON Schema: dependentRequired plus allOf entries.
+func (o *objectSchema) conditionSchemas() (map[string][]string, []*js.Schema) {
+	var deps map[string][]string
+	var all []*js.Schema
+	for _, c := range o.conds {
+		switch c.kind {
+		case condRequiredIf:
+			all = append(all, &js.Schema{
+				If:   &js.Schema{Properties: map[string]*js.Schema{c.when: {Const: c.equals}}, Required: []string{c.when}},
+				Then: &js.Schema{Required: []string{c.field}},
+			})
+		case condDependentRequired:
+			if deps == nil {
+				deps = map[string][]string{}
+			}
+			deps[c.field] = append(deps[c.field], c.fields...)
+		case condMutuallyExclusive:
+			var pairs []*js.Schema
+			for i := range c.fields {
+				for j := i + 1; j < len(c.fields); j++ {
+					pairs = append(pairs, &js.Schema{Required: []string{c.fields[i], c.fields[j]}})
+				}
+			}
+			if len(pairs) == 1 {
+				all = append(all, &js.Schema{Not: pairs[0]})
+			} else if len(pairs) > 1 {
+				all = append(all, &js.Schema{Not: &js.Schema{AnyOf: pairs}})
+			}
+		case condRequireOneOf:
+			one := make([]*js.Schema, len(c.fields))
+			for i, f := range c.fields {
+				one[i] = &js.Schema{Required: []string{f}}
+			}
+			all = append(all, &js.Schema{OneOf: one})
+		}
+	}
+	return deps, all
+}
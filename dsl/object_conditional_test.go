@@ -0,0 +1,156 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func paymentSchema() goskema.Schema[map[string]any] {
+	return g.Object().
+		Field("method", g.StringOf[string]()).Required().
+		Field("cardNumber", g.StringOf[string]()).DependentRequired("expiry").
+		RequiredIf("cardNumber", "method", "card").
+		Field("expiry", g.StringOf[string]()).
+		Field("email", g.StringOf[string]()).RequireOneOf("phone").
+		Field("phone", g.StringOf[string]()).MutuallyExclusive("fax").
+		Field("fax", g.StringOf[string]()).
+		MustBuild()
+}
+
+func issuePaths(t *testing.T, err error) map[string]string {
+	t.Helper()
+	iss, ok := goskema.AsIssues(err)
+	if !ok {
+		t.Fatalf("expected issues, got %v", err)
+	}
+	out := map[string]string{}
+	for _, it := range iss {
+		out[it.Path] = it.Code
+	}
+	return out
+}
+
+func TestConditional_ParseAndStreaming(t *testing.T) {
+	s := paymentSchema()
+	ok := `{"method":"card","cardNumber":"4111","expiry":"12/30","email":"a@example.com"}`
+	if _, err := goskema.ParseFrom(context.Background(), s, goskema.JSONReader(strings.NewReader(ok))); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if _, err := s.Parse(context.Background(), map[string]any{"method": "bank", "phone": "1"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	_, err := goskema.ParseFrom(context.Background(), s, goskema.JSONReader(strings.NewReader(`{"method":"card","phone":"1","fax":"2"}`)))
+	got := issuePaths(t, err)
+	if len(got) != 2 || got["/cardNumber"] != goskema.CodeRequired || got["/fax"] != goskema.CodeConflict {
+		t.Fatalf("unexpected issues: %v", got)
+	}
+
+	_, err = s.Parse(context.Background(), map[string]any{"method": "bank", "cardNumber": "4111"})
+	got = issuePaths(t, err)
+	if len(got) != 3 || got["/expiry"] != goskema.CodeRequired || got["/email"] != goskema.CodeRequired || got["/phone"] != goskema.CodeRequired {
+		t.Fatalf("unexpected issues: %v", got)
+	}
+
+	_, err = s.Parse(context.Background(), map[string]any{"method": "bank", "email": "e", "phone": "1"})
+	if iss, _ := goskema.AsIssues(err); len(iss) != 1 || iss[0].Path != "/phone" || iss[0].Code != goskema.CodeConflict || iss[0].Params["field"] != "email" {
+		t.Fatalf("unexpected issues: %v", err)
+	}
+
+	// Validate on values runs the same checks
+	if err := s.Validate(context.Background(), map[string]any{"method": "card", "email": "e"}); issuePaths(t, err)["/cardNumber"] != goskema.CodeRequired {
+		t.Fatalf("expected required at /cardNumber, got %v", err)
+	}
+}
+
+func TestConditional_JSONSchema(t *testing.T) {
+	sch, err := paymentSchema().JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(sch)
+	out := string(b)
+	for _, want := range []string{
+		`"dependentRequired":{"cardNumber":["expiry"]}`,
+		`{"if":{"properties":{"method":{"const":"card"}},"required":["method"]},"then":{"required":["cardNumber"]}}`,
+		`{"oneOf":[{"required":["email"]},{"required":["phone"]}]}`,
+		`{"not":{"required":["phone","fax"]}}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+}
+
+func TestConditional_DeriveAndBuild(t *testing.T) {
+	patch := g.Derive(paymentSchema()).Partial().MustBuild()
+	if _, err := patch.Parse(context.Background(), map[string]any{"method": "card"}); err != nil {
+		t.Fatalf("partial should drop conditional requirements: %v", err)
+	}
+	if _, err := patch.Parse(context.Background(), map[string]any{"phone": "1", "fax": "2"}); err == nil {
+		t.Fatal("partial should keep MutuallyExclusive")
+	}
+
+	noCard := g.Derive(paymentSchema()).Omit("cardNumber").MustBuild()
+	if _, err := noCard.Parse(context.Background(), map[string]any{"method": "card", "email": "e"}); err != nil {
+		t.Fatalf("conditions on omitted fields should be dropped: %v", err)
+	}
+
+	_, err := g.Object().Field("a", g.StringOf[string]()).RequiredIf("b", "x").Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/b" {
+		t.Fatalf("expected build error for missing field b, got %v", err)
+	}
+}
+
+func TestConditional_RequiredIfComparesParsedValues(t *testing.T) {
+	ctx := context.Background()
+	s := g.Object().
+		Field("amount", g.SchemaOf[json.Number](g.NumberJSON())).Required().
+		Field("region", g.SchemaOf[region](g.Enum[region]("tokyo", "osaka"))).
+		Field("approver", g.StringOf[string]()).RequiredIf("amount", 1).
+		Field("office", g.StringOf[string]()).RequiredIf("region", "tokyo").
+		MustBuild()
+
+	_, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"amount":1,"region":"tokyo"}`)))
+	got := issuePaths(t, err)
+	if len(got) != 2 || got["/approver"] != goskema.CodeRequired || got["/office"] != goskema.CodeRequired {
+		t.Fatalf("unexpected issues: %v", got)
+	}
+	if _, err := s.Parse(ctx, map[string]any{"amount": json.Number("2"), "region": "osaka"}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+
+	_, err = g.Object().
+		Field("amount", g.SchemaOf[json.Number](g.NumberJSON())).
+		Field("approver", g.StringOf[string]()).RequiredIf("amount", "many").
+		Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/amount" {
+		t.Fatalf("expected build error for a value amount rejects, got %v", err)
+	}
+}
+
+func TestConditional_TypedFieldSteps(t *testing.T) {
+	type contact struct {
+		Email string `json:"email"`
+		Phone string `json:"phone"`
+		Fax   string `json:"fax"`
+	}
+	s := g.ObjectOf[contact]().
+		Field("email", g.StringOf[string]()).RequireOneOf("phone").
+		Field("phone", g.StringOf[string]()).DependentRequired("fax").
+		Field("fax", g.StringOf[string]()).
+		MustBind()
+	_, err := s.Parse(context.Background(), map[string]any{"phone": "1"})
+	if got := issuePaths(t, err); len(got) != 1 || got["/fax"] != goskema.CodeRequired {
+		t.Fatalf("unexpected issues: %v", got)
+	}
+	_, err = s.Parse(context.Background(), map[string]any{"email": "e", "phone": "1", "fax": "2"})
+	if got := issuePaths(t, err); len(got) != 1 || got["/phone"] != goskema.CodeConflict {
+		t.Fatalf("unexpected issues: %v", got)
+	}
+}
@@ -19,6 +19,9 @@ type objectSchema struct {
 	// typed rules are stored at the builder and copied here
 	typedRulesAny any // holds []typedRule[T] at bind time; kept as any at map-level schema
 	sortedKeys    []string
+	aliases       map[string]string // deprecated wire name -> canonical field
+	sortedAliases []string
+	conds         []objCondition
 }
 
 // Ensure objectSchema implements goskema.Schema[map[string]any]
@@ -55,7 +58,11 @@ func (o *objectSchema) handleExistingField(ctx context.Context, k string, ad Any
 	if val == nil {
 		pm["/"+k] |= goskema.PresenceWasNull
 	}
-	parsed, err := ad.parse(ctx, val)
+	if ad.access&accessWriteOnly != 0 {
+		pm["/"+k] |= goskema.PresenceWriteOnly
+	}
+	parsed, flags, err := ad.parseTracked(childRequestCtx(scopeAtKey(ctx, k), k, ad), val)
+	pm["/"+k] |= flags
 	if err != nil {
 		// If child returned Issues, rebase them under "/field"
 		if child, ok := goskema.AsIssues(err); ok {
@@ -70,7 +77,7 @@ func (o *objectSchema) handleExistingField(ctx context.Context, k string, ad Any
 				} else {
 					p = base + "/" + p
 				}
-				out = goskema.AppendIssues(out, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause})
+				out = goskema.AppendIssues(out, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause, Params: it.Params})
 			}
 			return nil, out
 		}
@@ -84,11 +91,20 @@ func (o *objectSchema) handleMissingField(ctx context.Context, k string, ad AnyA
 	if ad.applyDefault == nil {
 		return nil, nil, false
 	}
-	dv, err := ad.applyDefault(ctx)
+	dv, err := ad.applyDefault(scopeAtKey(ctx, k))
+	if err == errNoDefault {
+		return nil, nil, false
+	}
 	if err != nil {
+		if child, ok := goskema.AsIssues(err); ok {
+			return nil, rebaseIssuesUnder("/"+k, child), true
+		}
 		return nil, issuesFromErr("/"+k, err), true
 	}
 	pm["/"+k] |= goskema.PresenceDefaultApplied
+	if ad.access&accessWriteOnly != 0 {
+		pm["/"+k] |= goskema.PresenceWriteOnly
+	}
 	return dv, nil, true
 }
 
@@ -96,8 +112,12 @@ func (o *objectSchema) handleMissingField(ctx context.Context, k string, ad AnyA
 func (o *objectSchema) collectKnownWithPresence(ctx context.Context, src map[string]any, pm goskema.PresenceMap) (map[string]any, goskema.Issues) {
 	out := make(map[string]any, len(src))
 	var iss goskema.Issues
+	var derived []string
 	for _, k := range o.sortedKnownKeys() {
 		ad := o.fields[k]
+		if skipsField(ctx, ad) {
+			continue
+		}
 		if val, exists := src[k]; exists {
 			parsed, i2 := o.handleExistingField(ctx, k, ad, val, pm)
 			if len(i2) > 0 {
@@ -110,20 +130,21 @@ func (o *objectSchema) collectKnownWithPresence(ctx context.Context, src map[str
 			out[k] = parsed
 			continue
 		}
-		// missing: apply default if provided; otherwise enforce required
-		if dv, i2, handled := o.handleMissingField(ctx, k, ad, pm); handled {
-			if len(i2) > 0 {
-				iss = goskema.AppendIssues(iss, i2...)
-				if goskema.IsFailFast(ctx) {
-					return out, iss
-				}
-			} else {
-				out[k] = dv
-			}
+		if ad.derivedDefault {
+			derived = append(derived, k)
 			continue
 		}
-		if _, req := o.required[k]; req {
-			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeRequired, Message: i18n.T(goskema.CodeRequired, nil), Hint: "required property missing"})
+		if i2 := o.applyMissing(ctx, k, ad, out, pm); len(i2) > 0 {
+			iss = goskema.AppendIssues(iss, i2...)
+			if goskema.IsFailFast(ctx) {
+				return out, iss
+			}
+		}
+	}
+	// DefaultFrom fields see every other field (including static defaults) of this object.
+	for _, k := range derived {
+		if i2 := o.applyMissing(withDefaultPartial(ctx, out), k, o.fields[k], out, pm); len(i2) > 0 {
+			iss = goskema.AppendIssues(iss, i2...)
 			if goskema.IsFailFast(ctx) {
 				return out, iss
 			}
@@ -132,6 +153,20 @@ func (o *objectSchema) collectKnownWithPresence(ctx context.Context, src map[str
 	return out, iss
 }
 
+// applyMissing applies the default of a missing field into out, or enforces required.
+func (o *objectSchema) applyMissing(ctx context.Context, k string, ad AnyAdapter, out map[string]any, pm goskema.PresenceMap) goskema.Issues {
+	if dv, iss, handled := o.handleMissingField(ctx, k, ad, pm); handled {
+		if len(iss) == 0 {
+			out[k] = dv
+		}
+		return iss
+	}
+	if _, req := o.required[k]; req {
+		return goskema.Issues{goskema.Issue{Path: "/" + k, Code: goskema.CodeRequired, Message: i18n.T(goskema.CodeRequired, nil), Hint: "required property missing"}}
+	}
+	return nil
+}
+
 // collectUnknown processes unknown keys according to unknownPolicy and may write into out for passthrough.
 func (o *objectSchema) collectUnknown(src map[string]any, out map[string]any) goskema.Issues {
 	var iss goskema.Issues
@@ -168,13 +203,50 @@ func (o *objectSchema) collectUnknown(src map[string]any, out map[string]any) go
 	return iss
 }
 
+// resolveAliases moves values given under a deprecated alias to their canonical field, reporting
+// a deprecated_field warning for each alias used and duplicate_key when a field is set under
+// more than one name. src is copied only when an alias is present.
+func (o *objectSchema) resolveAliases(ctx context.Context, src map[string]any) (map[string]any, goskema.Issues) {
+	var out map[string]any
+	var iss goskema.Issues
+	for _, a := range o.sortedAliases {
+		v, ok := src[a]
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]any, len(src))
+			for k, sv := range src {
+				out[k] = sv
+			}
+		}
+		delete(out, a)
+		field := o.aliases[a]
+		if _, dup := out[field]; dup {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + a, Code: goskema.CodeDuplicateKey, Message: i18n.T(goskema.CodeDuplicateKey, nil), Hint: field + " is already set under another name", Params: map[string]any{"field": field}})
+			continue
+		}
+		out[field] = v
+		goskema.ReportWarning(ctx, goskema.Issue{Path: "/" + a, Code: goskema.CodeDeprecatedField, Message: i18n.T(goskema.CodeDeprecatedField, nil), Hint: "use " + field, Params: map[string]any{"field": field}})
+	}
+	if out == nil {
+		return src, nil
+	}
+	return out, iss
+}
+
 func (o *objectSchema) Parse(ctx context.Context, v any) (map[string]any, error) {
 	src, ok := v.(map[string]any)
 	if !ok {
 		return nil, goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected object"}}
 	}
 	pm := goskema.PresenceMap{"/": goskema.PresenceSeen}
+	src, aiss := o.resolveAliases(ctx, src)
+	src, riss := o.readOnlyInput(ctx, src)
 	out, iss := o.collectKnownWithPresence(ctx, src, pm)
+	iss = append(append(aiss, riss...), iss...)
+	iss = goskema.AppendIssues(iss, o.parsedConditionIssues(src, out)...)
+	iss = goskema.AppendIssues(iss, o.immutableIssues(ctx, out)...)
 	if goskema.IsFailFast(ctx) && len(iss) > 0 {
 		return nil, iss
 	}
@@ -192,6 +264,7 @@ func (o *objectSchema) Parse(ctx context.Context, v any) (map[string]any, error)
 	if err := goskema.ApplyRefine[map[string]any](ctx, nn, o); err != nil {
 		return nil, err
 	}
+	reportPresence(ctx, pm)
 	return nn, nil
 }
 
@@ -203,8 +276,16 @@ func (o *objectSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decode
 		m, err := o.Parse(ctx, v)
 		return goskema.Decoded[map[string]any]{Value: m, Presence: pm}, err
 	}
+	// nested objects record their fields into pm as well (e.g. "/spec/replicas" defaulted)
+	outer := ctx
+	ctx = goskema.CollectPresence(ctx, pm)
 
+	src, aiss := o.resolveAliases(ctx, src)
+	src, riss := o.readOnlyInput(ctx, src)
 	out, iss := o.collectKnownWithPresence(ctx, src, pm)
+	iss = append(append(aiss, riss...), iss...)
+	iss = goskema.AppendIssues(iss, o.parsedConditionIssues(src, out)...)
+	iss = goskema.AppendIssues(iss, o.immutableIssues(ctx, out)...)
 	if goskema.IsFailFast(ctx) && len(iss) > 0 {
 		return goskema.Decoded[map[string]any]{Value: nil, Presence: pm}, iss
 	}
@@ -223,6 +304,7 @@ func (o *objectSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decode
 	if err := goskema.ApplyRefine[map[string]any](ctx, nn, o); err != nil {
 		return goskema.Decoded[map[string]any]{}, err
 	}
+	reportPresence(outer, pm)
 	return goskema.Decoded[map[string]any]{Value: nn, Presence: pm}, nil
 }
 
@@ -246,19 +328,30 @@ func (o *objectSchema) RuleCheck(ctx context.Context, v any) error {
 	}
 	sort.Strings(rks)
 	for _, k := range rks {
-		if _, ok := m[k]; !ok {
+		if _, ok := m[k]; !ok && !o.setViaAlias(m, k) {
 			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeRequired, Message: i18n.T(goskema.CodeRequired, nil), Hint: "required property missing"})
 			if goskema.IsFailFast(ctx) {
 				return iss
 			}
 		}
 	}
+	iss = goskema.AppendIssues(iss, o.conditionIssuesIn(m)...)
 	if len(iss) > 0 {
 		return iss
 	}
 	return nil
 }
 
+// setViaAlias reports whether field is present in m under one of its aliases.
+func (o *objectSchema) setViaAlias(m map[string]any, field string) bool {
+	for _, a := range o.sortedAliases {
+		if _, ok := m[a]; ok && o.aliases[a] == field {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *objectSchema) Validate(ctx context.Context, v any) error {
 	if err := o.TypeCheck(ctx, v); err != nil {
 		return err
@@ -283,19 +376,30 @@ func (o *objectSchema) ValidateValue(ctx context.Context, v map[string]any) erro
 			return goskema.Issues{goskema.Issue{Path: "/" + k, Code: goskema.CodeRequired, Message: i18n.T(goskema.CodeRequired, nil), Hint: "required property missing"}}
 		}
 	}
+	if iss := o.conditionIssuesIn(v); len(iss) > 0 {
+		return iss
+	}
 	return nil
 }
 
-func (o *objectSchema) JSONSchema() (*js.Schema, error) {
+func (o *objectSchema) JSONSchema() (*js.Schema, error) { return o.exportJSONSchema(&jsonExport{}) }
+
+func (o *objectSchema) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
 	props := make(map[string]*js.Schema, len(o.fields))
 	for k, ad := range o.fields {
 		if ad.jsonSchema != nil {
-			if ps, err := ad.jsonSchema(); err == nil && ps != nil {
-				props[k] = ps
+			if ps, err := ad.jsonSchema(ex); err == nil && ps != nil {
+				props[k] = accessSchema(ps, ad.access)
 				continue
 			}
 		}
-		props[k] = &js.Schema{}
+		props[k] = accessSchema(&js.Schema{}, ad.access)
+	}
+	// Aliases are accepted properties with the canonical field's schema, marked deprecated.
+	for a, field := range o.aliases {
+		ps := *props[field]
+		ps.Deprecated = true
+		props[a] = &ps
 	}
 	// Required list (sorted for deterministic output)
 	req := make([]string, 0, len(o.required))
@@ -316,7 +420,8 @@ func (o *objectSchema) JSONSchema() (*js.Schema, error) {
 		// UnknownPassthrough implies additionalProperties allowed in JSON Schema terms.
 		additional = true
 	}
-	return &js.Schema{Type: "object", Properties: props, Required: req, AdditionalProperties: additional}, nil
+	deps, all := o.conditionSchemas()
+	return &js.Schema{Type: "object", Properties: props, Required: req, AdditionalProperties: additional, DependentRequired: deps, AllOf: all}, nil
 }
 
 // Refine implements goskema.Refiner[map[string]any] using builder-registered hooks.
@@ -349,4 +454,6 @@ func (o *objectSchema) Refine(ctx context.Context, v map[string]any) error {
 type objRefine struct {
 	name string
 	fn   func(context.Context, map[string]any) error
+	// fields lists the top-level keys the refine reads (RefineFields); empty means undeclared.
+	fields []string
 }
@@ -0,0 +1,104 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// errNoDefault is returned by applyDefault when a DefaultFrom function yields nil; the field
+// is then treated as having no default (required still applies).
+var errNoDefault = errors.New("no default")
+
+type defaultPartialKey struct{}
+
+// withDefaultPartial exposes the fields parsed so far to DefaultFrom. A copy is handed out so
+// that the function cannot modify the object being built.
+func withDefaultPartial(ctx context.Context, out map[string]any) context.Context {
+	partial := make(map[string]any, len(out))
+	for k, v := range out {
+		partial[k] = v
+	}
+	return context.WithValue(ctx, defaultPartialKey{}, partial)
+}
+
+// withDefaultFunc installs a default computed at parse time. A value of the field's parsed type
+// (e.g. a time.Time for a codec field) is validated as is; anything else is parsed via the field
+// schema like a static default. JSON Schema carries no default for it.
+func (ad AnyAdapter) withDefaultFunc(fn func(context.Context) (any, error)) AnyAdapter {
+	parse, validate, out := ad.parse, ad.validateValue, ad.out
+	ad.applyDefault = func(ctx context.Context) (any, error) {
+		v, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if out != nil && out.Kind() != reflect.Interface && v != nil && reflect.TypeOf(v) == out {
+			if validate != nil {
+				if err := validate(ctx, v); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		}
+		return parse(ctx, v)
+	}
+	ad.derivedDefault, ad.computedDefault = false, true
+	if prev := ad.jsonSchema; prev != nil {
+		ad.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
+			s, err := prev(ex)
+			if err == nil && s != nil && s.Default != nil {
+				cp := *s
+				cp.Default = nil
+				s = &cp
+			}
+			return s, err
+		}
+	}
+	return ad
+}
+
+// withDefaultFrom installs a default derived from the sibling fields of the enclosing object.
+func (ad AnyAdapter) withDefaultFrom(fn func(partial map[string]any) any) AnyAdapter {
+	ad = ad.withDefaultFunc(func(ctx context.Context) (any, error) {
+		partial, _ := ctx.Value(defaultPartialKey{}).(map[string]any)
+		v := fn(partial)
+		if v == nil {
+			return nil, errNoDefault
+		}
+		return v, nil
+	})
+	ad.derivedDefault = true
+	return ad
+}
+
+// DefaultFunc sets a default computed when the field is missing (generated IDs, timestamps).
+// The value is checked by the field schema (values of its parsed type, such as a time.Time
+// for a time codec, are accepted without re-parsing) and records PresenceDefaultApplied, so
+// EncodePreserving drops it again. Use goskema.Now/goskema.NewID to keep tests reproducible.
+// Example: Field("id", g.StringOf[string]()).DefaultFunc(func(ctx context.Context) (any, error) { return goskema.NewID(ctx), nil })
+func (f *fieldStep) DefaultFunc(fn func(context.Context) (any, error)) *objectBuilder {
+	f.b.fields[f.name] = f.b.fields[f.name].withDefaultFunc(fn)
+	return f.b
+}
+
+// DefaultFrom sets a default derived from the other fields of the object, e.g. a slug from the
+// title. fn runs after every other field has been parsed or defaulted and receives a copy of
+// them; returning nil leaves the field missing.
+func (f *fieldStep) DefaultFrom(fn func(partial map[string]any) any) *objectBuilder {
+	f.b.fields[f.name] = f.b.fields[f.name].withDefaultFrom(fn)
+	return f.b
+}
+
+// DefaultFunc sets a default computed when the field is missing (see fieldStep.DefaultFunc).
+func (f *fieldStepT[T]) DefaultFunc(fn func(context.Context) (any, error)) *objectBuilderT[T] {
+	f.tb.inner.fields[f.name] = f.tb.inner.fields[f.name].withDefaultFunc(fn)
+	return f.tb
+}
+
+// DefaultFrom sets a default derived from the other fields (see fieldStep.DefaultFrom).
+func (f *fieldStepT[T]) DefaultFrom(fn func(partial map[string]any) any) *objectBuilderT[T] {
+	f.tb.inner.fields[f.name] = f.tb.inner.fields[f.name].withDefaultFrom(fn)
+	return f.tb
+}
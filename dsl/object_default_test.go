@@ -0,0 +1,103 @@
+package dsl_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/codec"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestDefaultFunc_ClockAndIDHooks(t *testing.T) {
+	fixed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := g.Object().
+		Field("id", g.StringOf[string]()).DefaultFunc(func(ctx context.Context) (any, error) { return goskema.NewID(ctx), nil }).
+		Field("createdAt", g.SchemaOf[time.Time](g.Codec[string, time.Time](codec.TimeRFC3339()))).DefaultFunc(func(ctx context.Context) (any, error) {
+		return goskema.Now(ctx), nil
+	}).
+		Field("name", g.StringOf[string]()).Required().
+		MustBuild()
+
+	ctx := goskema.WithClock(context.Background(), func() time.Time { return fixed })
+	ctx = goskema.WithIDFunc(ctx, func() string { return "id-1" })
+	dm, err := goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"name":"x"}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Value["id"] != "id-1" || dm.Value["createdAt"] != fixed {
+		t.Fatalf("unexpected values: %#v", dm.Value)
+	}
+	if dm.Presence["/id"]&goskema.PresenceDefaultApplied == 0 || dm.Presence["/createdAt"]&goskema.PresenceDefaultApplied == 0 {
+		t.Fatalf("expected default_applied: %v", dm.Presence)
+	}
+	out := goskema.EncodePreservingObject(dm)
+	if _, ok := out["id"]; ok {
+		t.Fatalf("computed default should be dropped on encode: %v", out)
+	}
+	if _, ok := out["createdAt"]; ok {
+		t.Fatalf("computed default should be dropped on encode: %v", out)
+	}
+
+	// without hooks a random UUID is generated
+	v, err := s.Parse(context.Background(), map[string]any{"name": "x"})
+	if err != nil || len(v["id"].(string)) != 36 {
+		t.Fatalf("unexpected random id: %v %v", v["id"], err)
+	}
+}
+
+func TestDefaultFunc_ErrorAndValidation(t *testing.T) {
+	s := g.Object().
+		Field("a", g.StringOf[string]()).DefaultFunc(func(ctx context.Context) (any, error) { return nil, errors.New("boom") }).
+		Field("b", g.SchemaOf[string](g.String().MinLen(3))).DefaultFunc(func(ctx context.Context) (any, error) { return "x", nil }).
+		MustBuild()
+	_, err := s.Parse(context.Background(), map[string]any{})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 2 || iss[0].Path != "/a" || iss[1].Path != "/b" || iss[1].Code != goskema.CodeTooShort {
+		t.Fatalf("unexpected issues: %v", err)
+	}
+}
+
+func TestDefaultFrom_SiblingFields(t *testing.T) {
+	type post struct {
+		Title string `json:"title"`
+		Slug  string `json:"slug"`
+		Zone  string `json:"zone"`
+	}
+	slug := func(p map[string]any) any {
+		title, ok := p["title"].(string)
+		if !ok {
+			return nil
+		}
+		return strings.ToLower(strings.ReplaceAll(title, " ", "-")) + "@" + p["zone"].(string)
+	}
+	s := g.ObjectOf[post]().
+		Field("slug", g.StringOf[string]()).DefaultFrom(slug).
+		Field("title", g.StringOf[string]()).
+		Field("zone", g.StringOf[string]()).Default("eu").
+		Require("slug").
+		MustBind()
+
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"title":"Hello World"}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Value.Slug != "hello-world@eu" || dm.Presence["/slug"]&goskema.PresenceDefaultApplied == 0 {
+		t.Fatalf("unexpected result: %+v %v", dm.Value, dm.Presence)
+	}
+
+	// given slug wins
+	v, err := s.Parse(context.Background(), map[string]any{"title": "A", "slug": "custom"})
+	if err != nil || v.Slug != "custom" {
+		t.Fatalf("unexpected result: %+v %v", v, err)
+	}
+
+	// nil result leaves the field missing, so required applies
+	_, err = s.Parse(context.Background(), map[string]any{})
+	if iss, ok := goskema.AsIssues(err); !ok || len(iss) != 1 || iss[0].Path != "/slug" || iss[0].Code != goskema.CodeRequired {
+		t.Fatalf("expected required at /slug, got %v", err)
+	}
+}
@@ -0,0 +1,323 @@
+package dsl
+
+import (
+	"sort"
+	"strings"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// Derive starts a new object builder from a built object schema (or a schema bound with
+// Bind/ObjectOf), so that near-copies (Create/Update/Response) can be derived instead of
+// rewritten. Fields, defaults, required set, unknown policy, refines and typed rules are copied;
+// the original schema is not modified.
+// Example: update := Derive(create).Partial().MustBuild()
+func Derive(s goskema.Schema[map[string]any]) *objectBuilder {
+	o, ok := s.(*objectSchema)
+	if !ok {
+		b := Object()
+		b.buildIssues = goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "Derive requires an object schema built with Object()"}}
+		return b
+	}
+	return builderFromSchema(o)
+}
+
+// DeriveOf is the typed variant of Derive for schemas bound to T.
+// Example: patch := DeriveOf[User](userSchema).Partial().MustBind()
+func DeriveOf[T any](s goskema.Schema[T]) *objectBuilderT[T] {
+	if ts, ok := s.(*typedObjectSchema[T]); ok {
+		return &objectBuilderT[T]{inner: builderFromSchema(ts.inner)}
+	}
+	b := Object()
+	b.buildIssues = goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "DeriveOf requires a schema bound with Bind/ObjectOf"}}
+	return &objectBuilderT[T]{inner: b}
+}
+
+func builderFromSchema(o *objectSchema) *objectBuilder {
+	b := Object()
+	for k, ad := range o.fields {
+		b.fields[k] = ad
+	}
+	for k := range o.required {
+		b.required[k] = struct{}{}
+	}
+	for a, field := range o.aliases {
+		b.aliases[a] = field
+	}
+	b.conds = append(b.conds, o.conds...)
+	b.unknownPolicy, b.unknownTarget = o.unknownPolicy, o.unknownTarget
+	b.refines = append(b.refines, o.refines...)
+	if raw, ok := o.typedRulesAny.([]any); ok {
+		b.typedRules = append(b.typedRules, raw...)
+	}
+	return b
+}
+
+// Clone returns an independent copy of the builder, so that one base can be derived several ways.
+func (b *objectBuilder) Clone() *objectBuilder {
+	out := Object()
+	for k, ad := range b.fields {
+		out.fields[k] = ad
+	}
+	for k := range b.required {
+		out.required[k] = struct{}{}
+	}
+	for a, field := range b.aliases {
+		out.aliases[a] = field
+	}
+	out.conds = append(out.conds, b.conds...)
+	out.unknownPolicy, out.unknownTarget = b.unknownPolicy, b.unknownTarget
+	out.refines = append(out.refines, b.refines...)
+	out.discriminator = b.discriminator
+	if b.variants != nil {
+		out.variants = make(map[string]goskema.Schema[map[string]any], len(b.variants))
+		for k, v := range b.variants {
+			out.variants[k] = v
+		}
+	}
+	out.typedRules = append(out.typedRules, b.typedRules...)
+	out.buildIssues = append(out.buildIssues, b.buildIssues...)
+	return out
+}
+
+// Extend adds (or replaces) optional fields. Use Require to mark added fields as required.
+func (b *objectBuilder) Extend(fields map[string]AnyAdapter) *objectBuilder {
+	for k, ad := range fields {
+		b.fields[k] = ad
+	}
+	return b
+}
+
+// Merge adds every field of another object schema; on overlap the other schema's definition and
+// required flag win, and its unknown policy replaces the current one.
+func (b *objectBuilder) Merge(other goskema.Schema[map[string]any]) *objectBuilder {
+	o, ok := other.(*objectSchema)
+	if !ok {
+		b.buildIssues = append(b.buildIssues, goskema.Issue{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "Merge requires an object schema built with Object()"})
+		return b
+	}
+	for k, ad := range o.fields {
+		b.fields[k] = ad
+		if _, req := o.required[k]; req {
+			b.required[k] = struct{}{}
+		} else {
+			delete(b.required, k)
+		}
+	}
+	for a, field := range o.aliases {
+		b.aliases[a] = field
+	}
+	b.conds = append(b.conds, o.conds...)
+	b.unknownPolicy, b.unknownTarget = o.unknownPolicy, o.unknownTarget
+	b.refines = append(b.refines, o.refines...)
+	if raw, ok := o.typedRulesAny.([]any); ok {
+		b.typedRules = append(b.typedRules, raw...)
+	}
+	return b
+}
+
+// Pick keeps only the given fields. Build fails if a refine or typed rule references a dropped field.
+func (b *objectBuilder) Pick(keys ...string) *objectBuilder {
+	keep := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		keep[k] = struct{}{}
+	}
+	for k := range b.fields {
+		if _, ok := keep[k]; !ok {
+			b.dropField(k)
+		}
+	}
+	return b
+}
+
+// Omit drops the given fields. Build fails if a refine or typed rule references a dropped field.
+func (b *objectBuilder) Omit(keys ...string) *objectBuilder {
+	for _, k := range keys {
+		b.dropField(k)
+	}
+	return b
+}
+
+func (b *objectBuilder) dropField(k string) {
+	delete(b.fields, k)
+	delete(b.required, k)
+	for a, field := range b.aliases {
+		if field == k {
+			delete(b.aliases, a)
+		}
+	}
+	var kept []objCondition
+	for _, c := range b.conds {
+		if c, ok := c.without(k); ok {
+			kept = append(kept, c)
+		}
+	}
+	b.conds = kept
+}
+
+// Partial makes every field optional, including conditional requirements (RequiredIf,
+// DependentRequired, RequireOneOf); MutuallyExclusive is kept. Defaults are kept and still
+// applied to missing fields.
+func (b *objectBuilder) Partial() *objectBuilder {
+	b.required = map[string]struct{}{}
+	var kept []objCondition
+	for _, c := range b.conds {
+		if !c.requiresPresence() {
+			kept = append(kept, c)
+		}
+	}
+	b.conds = kept
+	return b
+}
+
+// DeepPartial is Partial applied recursively to nested object fields (also bound with
+// ObjectOf/Bind) and to arrays and maps of objects. Build fails if a nested object cannot be
+// rebuilt.
+func (b *objectBuilder) DeepPartial() *objectBuilder {
+	b.Partial()
+	for k, ad := range b.fields {
+		pad, err := deepPartialAdapter(ad)
+		if err != nil {
+			b.buildIssues = goskema.AppendIssues(b.buildIssues, rebaseIssuesUnder("/"+k, issuesFromErr("/", err))...)
+			continue
+		}
+		b.fields[k] = pad
+	}
+	return b
+}
+
+// deepPartialer is implemented by schemas that are or contain object schemas. deepPartial
+// returns an adapter over a copy whose nested objects are partial (see DeepPartial), or a zero
+// AnyAdapter when there is nothing to rewrite.
+type deepPartialer interface {
+	deepPartial() (AnyAdapter, error)
+}
+
+// deepPartialAdapter rewrites adapters over object schemas (or containers of them) to partial
+// copies. Preprocess/Transform/Coerce, defaults and nullability of the original adapter are
+// preserved; other adapters are returned as is.
+func deepPartialAdapter(ad AnyAdapter) (AnyAdapter, error) {
+	dp, ok := ad.orig.(deepPartialer)
+	if !ok {
+		return ad, nil
+	}
+	out, err := dp.deepPartial()
+	if err != nil || out.orig == nil {
+		return ad, err
+	}
+	out = ad.rewrapOnto(out)
+	if ad.applyDefault != nil {
+		out.applyDefault, out.derivedDefault, out.computedDefault = ad.applyDefault, ad.derivedDefault, ad.computedDefault
+		inner, prev := out.jsonSchema, ad.jsonSchema
+		out.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
+			s, err := inner(ex)
+			if err != nil {
+				return nil, err
+			}
+			if ps, err := prev(ex); err == nil && ps != nil {
+				s.Default = ps.Default
+			}
+			return s, nil
+		}
+	}
+	if ad.nullable {
+		out = Nullable(out)
+	}
+	return out, nil
+}
+
+// deepPartialOf returns the partial copy of s, or nil when s holds no object schema.
+func deepPartialOf[V any](s goskema.Schema[V]) (goskema.Schema[V], error) {
+	dp, ok := any(s).(deepPartialer)
+	if !ok {
+		return nil, nil
+	}
+	ad, err := dp.deepPartial()
+	if err != nil || ad.orig == nil {
+		return nil, err
+	}
+	return ad.orig.(goskema.Schema[V]), nil
+}
+
+func (o *objectSchema) deepPartial() (AnyAdapter, error) {
+	ps, err := builderFromSchema(o).DeepPartial().Build()
+	if err != nil {
+		return AnyAdapter{}, err
+	}
+	return SchemaOf[map[string]any](ps), nil
+}
+
+func (s *typedObjectSchema[T]) deepPartial() (AnyAdapter, error) {
+	ps, err := builderFromSchema(s.inner).DeepPartial().Build()
+	if err != nil {
+		return AnyAdapter{}, err
+	}
+	ts, err := newTypedObjectSchema[T](ps.(*objectSchema))
+	if err != nil {
+		return AnyAdapter{}, err
+	}
+	return SchemaOf[T](ts), nil
+}
+
+func (a *ArraySchema[E]) deepPartial() (AnyAdapter, error) {
+	pe, err := deepPartialOf(a.elem)
+	if pe == nil {
+		return AnyAdapter{}, err
+	}
+	cp := *a
+	cp.elem = pe
+	return SchemaOf[[]E](&cp), nil
+}
+
+func (m mapSchema[V]) deepPartial() (AnyAdapter, error) {
+	pv, err := deepPartialOf(m.val)
+	if pv == nil {
+		return AnyAdapter{}, err
+	}
+	m.val = pv
+	return SchemaOf[map[string]V](m), nil
+}
+
+func (m *mapKVSchema[K, V]) deepPartial() (AnyAdapter, error) {
+	pv, err := deepPartialOf(m.val)
+	if pv == nil {
+		return AnyAdapter{}, err
+	}
+	cp := *m
+	cp.val = pv
+	return SchemaOf[map[K]V](&cp), nil
+}
+
+// danglingRuleRefs reports refines (RefineFields) and typed rules (RefineOpt.WhenSeen) that
+// reference fields not present in the builder, e.g. after Pick/Omit.
+func (b *objectBuilder) danglingRuleRefs() goskema.Issues {
+	var iss goskema.Issues
+	check := func(rule string, field string) {
+		if _, ok := b.fields[field]; ok || field == "" {
+			return
+		}
+		iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "rule " + rule + " references missing field " + field, Rule: rule, Params: map[string]any{"rule": rule, "field": field}})
+	}
+	for _, r := range b.refines {
+		for _, f := range r.fields {
+			check(r.name, f)
+		}
+	}
+	for _, raw := range b.typedRules {
+		pr, ok := raw.(interface {
+			referencedPaths() []string
+			ruleName() string
+		})
+		if !ok {
+			continue
+		}
+		for _, p := range pr.referencedPaths() {
+			seg, _, _ := strings.Cut(strings.TrimPrefix(p, "/"), "/")
+			check(pr.ruleName(), strings.ReplaceAll(strings.ReplaceAll(seg, "~1", "/"), "~0", "~"))
+		}
+	}
+	sort.SliceStable(iss, func(i, j int) bool { return iss[i].Hint < iss[j].Hint })
+	return iss
+}
@@ -0,0 +1,174 @@
+package dsl_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func userCreate() goskema.Schema[map[string]any] {
+	return g.Object().
+		Field("name", g.StringOf[string]()).Required().
+		Field("email", g.StringOf[string]()).Required().
+		Field("role", g.StringOf[string]()).Default("member").
+		Field("address", g.SchemaOf[map[string]any](g.Object().
+			Field("city", g.StringOf[string]()).Required().
+			Field("zip", g.StringOf[string]()).Required().
+			MustBuild())).
+		RefineFields("name-not-email", []string{"name", "email"}, func(ctx context.Context, m map[string]any) error {
+			if m["name"] != nil && m["name"] == m["email"] {
+				return errors.New("name must differ from email")
+			}
+			return nil
+		}).
+		UnknownStrict().
+		MustBuild()
+}
+
+func TestDerive_PartialKeepsDefaultsPolicyAndRefines(t *testing.T) {
+	ctx := context.Background()
+	update := g.Derive(userCreate()).Partial().MustBuild()
+
+	v, err := update.Parse(ctx, map[string]any{"email": "a@example.com"})
+	if err != nil || !reflect.DeepEqual(v, map[string]any{"email": "a@example.com", "role": "member"}) {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	if _, err := update.Parse(ctx, map[string]any{"extra": 1}); err == nil {
+		t.Fatalf("expected unknown_key under the carried-over strict policy")
+	}
+	if _, err := update.Parse(ctx, map[string]any{"name": "x", "email": "x"}); err == nil {
+		t.Fatalf("expected refine to be carried over")
+	}
+	// nested objects stay strict under Partial
+	if _, err := update.Parse(ctx, map[string]any{"address": map[string]any{"city": "Tokyo"}}); err == nil {
+		t.Fatalf("expected nested required to remain with Partial")
+	}
+	// but become optional under DeepPartial
+	patch := g.Derive(userCreate()).DeepPartial().MustBuild()
+	if _, err := patch.Parse(ctx, map[string]any{"address": map[string]any{"city": "Tokyo"}}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	// the source schema is unchanged
+	if _, err := userCreate().Parse(ctx, map[string]any{"email": "a@example.com"}); err == nil {
+		t.Fatalf("expected create schema to keep required fields")
+	}
+}
+
+func TestDerive_PickOmitExtendMerge(t *testing.T) {
+	ctx := context.Background()
+	response := g.Derive(userCreate()).
+		Omit("address").
+		Extend(map[string]g.AnyAdapter{"id": g.StringOf[string]()}).
+		Require("id").
+		MustBuild()
+	if _, err := response.Parse(ctx, map[string]any{"id": "1", "name": "n", "email": "e"}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	if _, err := response.Parse(ctx, map[string]any{"id": "1", "name": "n", "email": "e", "address": map[string]any{}}); err == nil {
+		t.Fatalf("expected omitted field to be unknown")
+	}
+
+	server := g.Object().Field("createdAt", g.StringOf[string]()).Required().UnknownStrip().MustBuild()
+	merged := g.Derive(userCreate()).Merge(server).MustBuild()
+	v, err := merged.Parse(ctx, map[string]any{"name": "n", "email": "e", "createdAt": "now", "junk": 1})
+	if err != nil || v["createdAt"] != "now" || v["junk"] != nil {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+
+	// dropping a field referenced by a refine is rejected at Build
+	_, err = g.Derive(userCreate()).Pick("name").Build()
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Rule != "name-not-email" || iss[0].Params["field"] != "email" {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+type derivedUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func TestDeriveOf_TypedRulesAndClone(t *testing.T) {
+	ctx := context.Background()
+	base := g.ObjectOf[derivedUser]().
+		Field("name", g.StringOf[string]()).Required().
+		Field("email", g.StringOf[string]()).Required().
+		RefineT("email-set", func(dc goskema.DomainCtx[derivedUser], u derivedUser) []goskema.Issue {
+			if u.Email == "" {
+				return []goskema.Issue{{Path: "/email", Code: goskema.CodeBusinessRule}}
+			}
+			return nil
+		}, goskema.RefineOpt[derivedUser]{WhenSeen: []string{"/email"}}).
+		UnknownStrict()
+
+	patch := base.Clone().Partial().MustBind()
+	if u, err := patch.Parse(ctx, map[string]any{"name": "n"}); err != nil || u.Name != "n" {
+		t.Fatalf("unexpected: %v %v", u, err)
+	}
+	create := base.MustBind()
+	if _, err := create.Parse(ctx, map[string]any{"name": "n"}); err == nil {
+		t.Fatalf("Clone must not affect the base builder")
+	}
+	if _, err := g.DeriveOf[derivedUser](create).Omit("email").Bind(); err == nil {
+		t.Fatalf("expected Build error for typed rule gated on a dropped field")
+	}
+	if _, err := g.DeriveOf[derivedUser](create).Omit("name").Bind(); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+}
+
+type derivePort struct {
+	Name string `json:"name"`
+	Port int    `json:"port"`
+}
+
+type deriveService struct {
+	Name string     `json:"name"`
+	Main derivePort `json:"main"`
+}
+
+func TestDerive_DeepPartialContainersAndTyped(t *testing.T) {
+	ctx := context.Background()
+	port := g.Object().
+		Field("name", g.StringOf[string]()).Required().
+		Field("port", g.IntOf[int]()).Required().
+		MustBuild()
+	base := g.Object().
+		Field("byName", g.MapOf[map[string]any](port)).
+		Field("byRegion", g.MapKVOf(goskema.Schema[string](g.String()), port)).
+		Field("backup", g.SchemaOf[map[string]any](port).Nullable()).
+		MustBuild()
+	patch := g.Derive(base).DeepPartial().MustBuild()
+	in := map[string]any{
+		"byName":   map[string]any{"http": map[string]any{"port": 80}},
+		"byRegion": map[string]any{"tokyo": map[string]any{"name": "http"}},
+		"backup":   nil,
+	}
+	if _, err := patch.Parse(ctx, in); err != nil {
+		t.Fatalf("maps of objects should be partial: %v", err)
+	}
+	if _, err := base.Parse(ctx, in); err == nil {
+		t.Fatalf("expected the base schema to keep nested required fields")
+	}
+	if _, err := patch.Parse(ctx, map[string]any{"backup": map[string]any{"port": 1}}); err != nil {
+		t.Fatalf("nullable object should be partial: %v", err)
+	}
+
+	portT := g.ObjectOf[derivePort]().
+		Field("name", g.StringOf[string]()).Required().
+		Field("port", g.IntOf[int]()).Required().
+		MustBind()
+	svc := g.ObjectOf[deriveService]().
+		Field("name", g.StringOf[string]()).Required().
+		Field("main", g.SchemaOf[derivePort](portT)).Required().
+		MustBind()
+	svcPatch := g.DeriveOf[deriveService](svc).DeepPartial().MustBind()
+	v, err := svcPatch.Parse(ctx, map[string]any{"main": map[string]any{"port": 8080}})
+	if err != nil || v.Main.Port != 8080 {
+		t.Fatalf("typed nested object should be partial: %+v %v", v, err)
+	}
+}
@@ -3,6 +3,7 @@ package dsl
 import (
 	"context"
 	"sort"
+	"strconv"
 
 	goskema "github.com/reoring/goskema"
 	"github.com/reoring/goskema/i18n"
@@ -22,11 +23,31 @@ func rebaseIssuesUnder(base string, child goskema.Issues) goskema.Issues {
 		} else {
 			p = base + "/" + p
 		}
-		out = goskema.AppendIssues(out, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause})
+		out = goskema.AppendIssues(out, goskema.Issue{Path: p, Code: it.Code, Message: it.Message, Hint: it.Hint, Cause: it.Cause, Params: it.Params})
 	}
 	return out
 }
 
+// scopeAtKey scopes warnings and presence reported while parsing the child at key k (see
+// goskema.WarningsUnder and goskema.PresenceUnder).
+func scopeAtKey(ctx context.Context, k string) context.Context {
+	if goskema.CollectsWarnings(ctx) {
+		ctx = goskema.WarningsUnder(ctx, "/"+k)
+	}
+	if goskema.CollectsPresence(ctx) {
+		ctx = goskema.PresenceUnder(ctx, "/"+k)
+	}
+	return ctx
+}
+
+// scopeAtIndex is scopeAtKey for array elements.
+func scopeAtIndex(ctx context.Context, i int) context.Context {
+	if !goskema.CollectsWarnings(ctx) && !goskema.CollectsPresence(ctx) {
+		return ctx
+	}
+	return scopeAtKey(ctx, strconv.Itoa(i))
+}
+
 // parseKnownValue parses a known field value using streaming adapter when available, otherwise
 // falls back to decoding into any and parsing. Errors from children are rebased under "/field".
 func (o *objectSchema) parseKnownValue(
@@ -38,7 +59,20 @@ func (o *objectSchema) parseKnownValue(
 	opt goskema.ParseOpt,
 	field string,
 ) (any, goskema.Issues) {
-	base := "/" + field
+	return parseAdapterFromSource(ctx, src, subtree, first, ad, opt, "/"+field)
+}
+
+// parseAdapterFromSource parses one value (starting at first) with ad, preferring the adapter's
+// streaming path and falling back to decoding into any. Child issues are rebased under base.
+func parseAdapterFromSource(
+	ctx context.Context,
+	src goskema.Source,
+	subtree eng.TokenSource,
+	first eng.Token,
+	ad AnyAdapter,
+	opt goskema.ParseOpt,
+	base string,
+) (any, goskema.Issues) {
 	if ad.parseFromSource != nil {
 		pre := str.NewPreloadedSource(subtree, first)
 		pv, perr := ad.parseFromSource(ctx, goskema.SourceFromEngine(pre, src.NumberMode()), opt)
@@ -155,25 +189,27 @@ func (o *objectSchema) applyDefaultsOrRequired(
 	present map[string]struct{},
 ) (goskema.Issues, bool) {
 	var iss goskema.Issues
+	var derived []string
+	pm := goskema.PresenceMap{}
 	for _, k := range o.sortedKnownKeys() {
 		if _, ok := present[k]; ok {
 			continue
 		}
 		ad := o.fields[k]
-		if ad.applyDefault != nil {
-			dv, derr := ad.applyDefault(ctx)
-			if derr != nil {
-				iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeParseError, Message: derr.Error(), Cause: derr})
-				if goskema.IsFailFast(ctx) {
-					return iss, true
-				}
-				continue
-			}
-			out[k] = dv
+		if ad.derivedDefault {
+			derived = append(derived, k)
 			continue
 		}
-		if _, rq := o.required[k]; rq {
-			iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + k, Code: goskema.CodeRequired, Message: i18n.T(goskema.CodeRequired, nil), Hint: "required property missing"})
+		if i2 := o.applyMissing(ctx, k, ad, out, pm); len(i2) > 0 {
+			iss = goskema.AppendIssues(iss, i2...)
+			if goskema.IsFailFast(ctx) {
+				return iss, true
+			}
+		}
+	}
+	for _, k := range derived {
+		if i2 := o.applyMissing(withDefaultPartial(ctx, out), k, o.fields[k], out, pm); len(i2) > 0 {
+			iss = goskema.AppendIssues(iss, i2...)
 			if goskema.IsFailFast(ctx) {
 				return iss, true
 			}
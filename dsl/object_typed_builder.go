@@ -40,6 +40,47 @@ func (tb *objectBuilderT[T]) Refine(name string, fn func(context.Context, map[st
 	return tb
 }
 
+// RefineFields is like Refine but declares the fields the refine reads (see objectBuilder.RefineFields).
+func (tb *objectBuilderT[T]) RefineFields(name string, fields []string, fn func(context.Context, map[string]any) error) *objectBuilderT[T] {
+	tb.inner.RefineFields(name, fields, fn)
+	return tb
+}
+
+// Clone returns an independent copy of the typed builder.
+func (tb *objectBuilderT[T]) Clone() *objectBuilderT[T] {
+	return &objectBuilderT[T]{inner: tb.inner.Clone()}
+}
+
+// Extend adds (or replaces) optional fields.
+func (tb *objectBuilderT[T]) Extend(fields map[string]AnyAdapter) *objectBuilderT[T] {
+	tb.inner.Extend(fields)
+	return tb
+}
+
+// Merge adds every field of another object schema (its definitions win on overlap).
+func (tb *objectBuilderT[T]) Merge(other goskema.Schema[map[string]any]) *objectBuilderT[T] {
+	tb.inner.Merge(other)
+	return tb
+}
+
+// Pick keeps only the given fields.
+func (tb *objectBuilderT[T]) Pick(keys ...string) *objectBuilderT[T] {
+	tb.inner.Pick(keys...)
+	return tb
+}
+
+// Omit drops the given fields.
+func (tb *objectBuilderT[T]) Omit(keys ...string) *objectBuilderT[T] {
+	tb.inner.Omit(keys...)
+	return tb
+}
+
+// Partial makes every field optional.
+func (tb *objectBuilderT[T]) Partial() *objectBuilderT[T] { tb.inner.Partial(); return tb }
+
+// DeepPartial makes every field optional, recursively for nested objects.
+func (tb *objectBuilderT[T]) DeepPartial() *objectBuilderT[T] { tb.inner.DeepPartial(); return tb }
+
 // RefineT registers a typed domain rule (default PhaseDomain).
 func (tb *objectBuilderT[T]) RefineT(name string, fn func(goskema.DomainCtx[T], T) []goskema.Issue, opt ...goskema.RefineOpt[T]) *objectBuilderT[T] {
 	if fn == nil {
@@ -105,6 +146,12 @@ func (f *fieldStepT[T]) Optional() *objectBuilderT[T] {
 	return f.tb
 }
 
+// Alias accepts the given deprecated wire names for the current field (see fieldStep.Alias).
+func (f *fieldStepT[T]) Alias(names ...string) *fieldStepT[T] {
+	f.tb.inner.addAliases(f.name, names)
+	return f
+}
+
 // Deprecated: Prefer Field(...).Required() for a single field.
 // Use the builder-level Require("a","b") for marking multiple fields at once.
 // This method remains for backward compatibility but will be removed in a future major release.
@@ -120,12 +167,13 @@ func (f *fieldStepT[T]) Default(v any) *objectBuilderT[T] {
 	ad := f.tb.inner.fields[f.name]
 	// Apply default by parsing via the field schema to leverage Normalize/Validate/Refine
 	ad.applyDefault = func(ctx context.Context) (any, error) { return ad.parse(ctx, v) }
+	ad.computedDefault = false
 	prev := ad.jsonSchema
-	ad.jsonSchema = func() (*js.Schema, error) {
+	ad.jsonSchema = func(ex *jsonExport) (*js.Schema, error) {
 		if prev == nil {
 			return &js.Schema{Default: v}, nil
 		}
-		s, err := prev()
+		s, err := prev(ex)
 		if err != nil {
 			return nil, err
 		}
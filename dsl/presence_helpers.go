@@ -1,6 +1,7 @@
 package dsl
 
 import (
+	"context"
 	"strconv"
 
 	goskema "github.com/reoring/goskema"
@@ -29,3 +30,37 @@ func markPresenceSubtree(pm goskema.PresenceMap, base string, v any) {
 		// primitives: nothing further
 	}
 }
+
+// parseWithPresence runs parse with pm installed as presence collector (goskema.CollectPresence),
+// so that objects nested in the value record the presence of their fields under their pointers
+// (e.g. "/items/0/qty" defaulted). On success pm is forwarded to the enclosing collector, if any.
+func parseWithPresence[T any](ctx context.Context, pm goskema.PresenceMap, parse func(context.Context) (T, error)) (goskema.Decoded[T], error) {
+	v, err := parse(goskema.CollectPresence(ctx, pm))
+	if err == nil {
+		reportPresence(ctx, pm)
+	}
+	return goskema.Decoded[T]{Value: v, Presence: pm}, err
+}
+
+// reportPresence forwards the presence of a parsed value's children to the collector of ctx;
+// the value's own entry is recorded by its container.
+func reportPresence(ctx context.Context, pm goskema.PresenceMap) {
+	if !goskema.CollectsPresence(ctx) {
+		return
+	}
+	for p, bits := range pm {
+		goskema.RecordPresence(ctx, p, bits)
+	}
+}
+
+// reportEntry records the map entry at key k as seen (and null) to the collector of ctx.
+func reportEntry(ctx context.Context, k string, v any) {
+	if !goskema.CollectsPresence(ctx) {
+		return
+	}
+	p := goskema.PresenceSeen
+	if v == nil {
+		p |= goskema.PresenceWasNull
+	}
+	goskema.RecordPresence(ctx, "/"+k, p)
+}
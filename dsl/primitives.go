@@ -13,8 +13,8 @@ import (
 	js "github.com/reoring/goskema/jsonschema"
 )
 
-// String returns the minimal string schema implementation.
-func String() goskema.Schema[string] { return stringSchema{} }
+// String returns a string schema builder. Without constraints it accepts any string.
+func String() StringBuilder { return newStringSchema() }
 
 // Bool returns the minimal bool schema implementation.
 func Bool() goskema.Schema[bool] { return boolSchema{} }
@@ -22,10 +22,10 @@ func Bool() goskema.Schema[bool] { return boolSchema{} }
 // StringOf returns an AnyAdapter for a string wire schema projected to domain type T.
 // Wraps String() schema for domain-specific string projection.
 // stringAsSchema wraps stringSchema and projects to a domain type T with underlying string.
-type stringAsSchema[T ~string] struct{}
+type stringAsSchema[T ~string] struct{ s *stringSchema }
 
-func (stringAsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
-	s, err := (stringSchema{}).Parse(ctx, v)
+func (a stringAsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	s, err := a.s.Parse(ctx, v)
 	if err != nil {
 		var zero T
 		return zero, err
@@ -33,8 +33,8 @@ func (stringAsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 	return T(s), nil
 }
 
-func (stringAsSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
-	ds, err := (stringSchema{}).ParseWithMeta(ctx, v)
+func (a stringAsSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	ds, err := a.s.ParseWithMeta(ctx, v)
 	if err != nil {
 		var zero goskema.Decoded[T]
 		return zero, err
@@ -43,16 +43,16 @@ func (stringAsSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Deco
 }
 
 // ---- streaming SPI ----
-func (stringAsSchema[T]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (T, error) {
-	s, err := (stringSchema{}).ParseFromSource(ctx, src, opt)
+func (a stringAsSchema[T]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (T, error) {
+	s, err := a.s.ParseFromSource(ctx, src, opt)
 	if err != nil {
 		var zero T
 		return zero, err
 	}
 	return T(s), nil
 }
-func (stringAsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[T], error) {
-	ds, err := (stringSchema{}).ParseFromSourceWithMeta(ctx, src, opt)
+func (a stringAsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[T], error) {
+	ds, err := a.s.ParseFromSourceWithMeta(ctx, src, opt)
 	if err != nil {
 		var zero goskema.Decoded[T]
 		return zero, err
@@ -60,25 +60,21 @@ func (stringAsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 	return goskema.Decoded[T]{Value: T(ds.Value), Presence: ds.Presence}, nil
 }
 
-func (stringAsSchema[T]) TypeCheck(ctx context.Context, v any) error {
-	return (stringSchema{}).TypeCheck(ctx, v)
+func (a stringAsSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	return a.s.TypeCheck(ctx, v)
 }
-func (stringAsSchema[T]) RuleCheck(ctx context.Context, v any) error {
-	return (stringSchema{}).RuleCheck(ctx, v)
+func (a stringAsSchema[T]) RuleCheck(ctx context.Context, v any) error {
+	return a.s.RuleCheck(ctx, v)
 }
-func (stringAsSchema[T]) Validate(ctx context.Context, v any) error {
-	return (stringSchema{}).Validate(ctx, v)
+func (a stringAsSchema[T]) Validate(ctx context.Context, v any) error {
+	return a.s.Validate(ctx, v)
 }
-func (stringAsSchema[T]) ValidateValue(ctx context.Context, v T) error {
-	return (stringSchema{}).ValidateValue(ctx, string(v))
+func (a stringAsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	return a.s.ValidateValue(ctx, string(v))
 }
-func (stringAsSchema[T]) JSONSchema() (*js.Schema, error) { return (stringSchema{}).JSONSchema() }
+func (a stringAsSchema[T]) JSONSchema() (*js.Schema, error) { return a.s.JSONSchema() }
 
-func StringOf[T ~string]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](stringAsSchema[T]{})
-	ad.orig = stringSchema{}
-	return ad
-}
+func StringOf[T ~string]() AnyAdapter { return StringOfSchema[T](String()) }
 
 // BoolOf returns an AnyAdapter for a bool wire schema projected to domain type T.
 // Wraps Bool() schema for domain-specific bool projection.
@@ -140,53 +136,69 @@ func BoolOf[T ~bool]() AnyAdapter {
 }
 
 // NumberBuilder exposes chaining options for number schemas while implementing Schema[json.Number].
+// Bounds are given as json.Number text and compared exactly (no float rounding).
 type NumberBuilder interface {
 	goskema.Schema[json.Number]
 	CoerceFromString() NumberBuilder
+	// Min sets an inclusive lower bound.
+	Min(v json.Number) NumberBuilder
+	// Max sets an inclusive upper bound.
+	Max(v json.Number) NumberBuilder
+	// ExclusiveMin sets an exclusive lower bound.
+	ExclusiveMin(v json.Number) NumberBuilder
+	// ExclusiveMax sets an exclusive upper bound.
+	ExclusiveMax(v json.Number) NumberBuilder
+	// MultipleOf requires the value to be an integer multiple of v (v > 0).
+	MultipleOf(v json.Number) NumberBuilder
+	// Positive requires the value to be > 0.
+	Positive() NumberBuilder
+	// NonNegative requires the value to be >= 0.
+	NonNegative() NumberBuilder
 }
 
 // NumberJSON returns the minimal json.Number schema implementation (no string coerce by default).
 func NumberJSON() NumberBuilder { return &numberJSONSchema{} }
 
-type stringSchema struct{}
-
 type boolSchema struct{}
 
-// numberJSONSchema implements NumberBuilder with optional string coercion.
-type numberJSONSchema struct{ coerceFromString bool }
+// numberJSONSchema implements NumberBuilder with optional string coercion and range bounds.
+type numberJSONSchema struct {
+	coerceFromString bool
+	bounds           numberBounds
+}
 
 func (n *numberJSONSchema) CoerceFromString() NumberBuilder {
 	n.coerceFromString = true
 	return n
 }
 
-func (stringSchema) Parse(ctx context.Context, v any) (string, error) {
-	s, ok := v.(string)
+func (s *stringSchema) Parse(ctx context.Context, v any) (string, error) {
+	str, ok := v.(string)
 	if !ok {
 		return "", goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil)}}
 	}
 	// Normalize -> ValidateValue -> Refine
-	ns, err := goskema.ApplyNormalize[string](ctx, s, stringSchema{})
+	ns, err := goskema.ApplyNormalize[string](ctx, str, s)
 	if err != nil {
 		return "", err
 	}
-	s = ns
-	if err := (stringSchema{}).ValidateValue(ctx, s); err != nil {
+	str = ns
+	if err := s.ValidateValue(ctx, str); err != nil {
 		return "", err
 	}
-	if err := goskema.ApplyRefine[string](ctx, s, stringSchema{}); err != nil {
+	if err := goskema.ApplyRefine[string](ctx, str, s); err != nil {
 		return "", err
 	}
-	return s, nil
+	return str, nil
 }
 
-func (stringSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[string], error) {
-	s, err := (stringSchema{}).Parse(ctx, v)
-	return goskema.Decoded[string]{Value: s, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+func (s *stringSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[string], error) {
+	str, err := s.Parse(ctx, v)
+	return goskema.Decoded[string]{Value: str, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
 }
 
 // ---- streaming SPI ----
-func (stringSchema) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (string, error) {
+func (s *stringSchema) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (string, error) {
 	engSrc := goskema.EngineTokenSource(src)
 	tok, err := engSrc.NextToken()
 	if err != nil {
@@ -195,44 +207,53 @@ func (stringSchema) ParseFromSource(ctx context.Context, src goskema.Source, opt
 	if tok.Kind != eng.KindString {
 		return "", goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil)}}
 	}
-	s := tok.String
-	ns, nerr := goskema.ApplyNormalize[string](ctx, s, stringSchema{})
+	str := tok.String
+	ns, nerr := goskema.ApplyNormalize[string](ctx, str, s)
 	if nerr != nil {
 		return "", nerr
 	}
-	if err := (stringSchema{}).ValidateValue(ctx, ns); err != nil {
+	if err := s.ValidateValue(ctx, ns); err != nil {
 		return "", err
 	}
-	if err := goskema.ApplyRefine[string](ctx, ns, stringSchema{}); err != nil {
+	if err := goskema.ApplyRefine[string](ctx, ns, s); err != nil {
 		return "", err
 	}
 	return ns, nil
 }
 
-func (stringSchema) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[string], error) {
-	v, err := (stringSchema{}).ParseFromSource(ctx, src, opt)
+func (s *stringSchema) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[string], error) {
+	v, err := s.ParseFromSource(ctx, src, opt)
 	return goskema.Decoded[string]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
 }
 
-func (stringSchema) TypeCheck(ctx context.Context, v any) error {
+func (s *stringSchema) TypeCheck(ctx context.Context, v any) error {
 	if _, ok := v.(string); !ok {
 		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil)}}
 	}
 	return nil
 }
 
-func (stringSchema) RuleCheck(ctx context.Context, v any) error { return nil }
+func (s *stringSchema) RuleCheck(ctx context.Context, v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return s.ValidateValue(ctx, str)
+}
 
-func (stringSchema) Validate(ctx context.Context, v any) error {
-	if err := (stringSchema{}).TypeCheck(ctx, v); err != nil {
+func (s *stringSchema) Validate(ctx context.Context, v any) error {
+	if err := s.TypeCheck(ctx, v); err != nil {
 		return err
 	}
-	return (stringSchema{}).RuleCheck(ctx, v)
+	return s.RuleCheck(ctx, v)
 }
 
-func (stringSchema) ValidateValue(ctx context.Context, v string) error { return nil }
-
-func (stringSchema) JSONSchema() (*js.Schema, error) { return &js.Schema{Type: "string"}, nil }
+func (s *stringSchema) ValidateValue(ctx context.Context, v string) error {
+	if iss := s.checkConstraints(ctx, v); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
 
 func (boolSchema) Parse(ctx context.Context, v any) (bool, error) {
 	b, ok := v.(bool)
@@ -362,11 +383,7 @@ func (s numberAsSchema[T]) JSONSchema() (*js.Schema, error) { return (&s.n).JSON
 
 // NumberOf returns an AnyAdapter for a json.Number wire schema projected to domain type T.
 // Wraps NumberJSON() schema for domain-specific number projection.
-func NumberOf[T ~string]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](numberAsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
-}
+func NumberOf[T ~string]() AnyAdapter { return NumberOfSchema[T](NumberJSON()) }
 
 // ---------------- IntOf[T] ----------------
 // intAsSchema wraps numberJSONSchema and projects to a domain type T with underlying int.
@@ -377,26 +394,26 @@ func (s intAsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 	// Allow direct int for default application ergonomics.
 	switch t := v.(type) {
 	case int:
-		return T(t), nil
+		return validatedNumber[T](ctx, s, T(t))
 	case int8:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case int16:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case int32:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case int64:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case uint:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case uint8:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case uint16:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case uint32:
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	case uint64:
 		// Best-effort downcast; overflow will be caught by Go's int range.
-		return T(int(t)), nil
+		return validatedNumber[T](ctx, s, T(int(t)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -439,19 +456,22 @@ func (s intAsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema
 	return goskema.Decoded[T]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
 }
 
-func (s intAsSchema[T]) TypeCheck(ctx context.Context, v any) error   { return (&s.n).TypeCheck(ctx, v) }
-func (s intAsSchema[T]) RuleCheck(ctx context.Context, v any) error   { return (&s.n).RuleCheck(ctx, v) }
-func (s intAsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s intAsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s intAsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
+func (s intAsSchema[T]) TypeCheck(ctx context.Context, v any) error { return (&s.n).TypeCheck(ctx, v) }
+func (s intAsSchema[T]) RuleCheck(ctx context.Context, v any) error { return (&s.n).RuleCheck(ctx, v) }
+func (s intAsSchema[T]) Validate(ctx context.Context, v any) error  { return (&s.n).Validate(ctx, v) }
+func (s intAsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, intText(int64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s intAsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
+}
 
 // IntOf returns an AnyAdapter for a json.Number wire schema projected to domain type T(~int).
 // It accepts JSON numbers like 1 or 2 (not strings unless NumberMode coerces) and decodes to T.
-func IntOf[T ~int]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](intAsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
-}
+func IntOf[T ~int]() AnyAdapter { return IntOfSchema[T](NumberJSON()) }
 
 // ---------------- FloatOf[T] ----------------
 // floatAsSchema wraps numberJSONSchema and projects to a domain type T with underlying float64.
@@ -461,7 +481,7 @@ type floatAsSchema[T ~float64] struct{ n numberJSONSchema }
 func (s floatAsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 	// Accept direct float64 for default application ergonomics
 	if f, ok := v.(float64); ok {
-		return T(f), nil
+		return validatedNumber[T](ctx, s, T(f))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -511,16 +531,19 @@ func (s floatAsSchema[T]) TypeCheck(ctx context.Context, v any) error {
 func (s floatAsSchema[T]) RuleCheck(ctx context.Context, v any) error {
 	return (&s.n).RuleCheck(ctx, v)
 }
-func (s floatAsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s floatAsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s floatAsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "number"}, nil }
+func (s floatAsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s floatAsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, strconvFormatFloat(float64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s floatAsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "number"}), nil
+}
 
 // FloatOf returns an AnyAdapter for a json.Number wire schema projected to domain type T(~float64).
-func FloatOf[T ~float64]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](floatAsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
-}
+func FloatOf[T ~float64]() AnyAdapter { return FloatOfSchema[T](NumberJSON()) }
 
 // ---------------- UintOf[T] ----------------
 // uintAsSchema projects json.Number to domain type T with underlying uint64.
@@ -531,7 +554,7 @@ func (s uintAsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 	// Accept common unsigned ints directly for defaults/validation convenience
 	switch t := v.(type) {
 	case uint, uint8, uint16, uint32, uint64:
-		return T(reflect.ValueOf(t).Convert(reflect.TypeOf(uint64(0))).Uint()), nil
+		return validatedNumber[T](ctx, s, T(reflect.ValueOf(t).Convert(reflect.TypeOf(uint64(0))).Uint()))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -575,22 +598,107 @@ func (s uintAsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 	return goskema.Decoded[T]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
 }
 
-func (s uintAsSchema[T]) TypeCheck(ctx context.Context, v any) error   { return (&s.n).TypeCheck(ctx, v) }
-func (s uintAsSchema[T]) RuleCheck(ctx context.Context, v any) error   { return (&s.n).RuleCheck(ctx, v) }
-func (s uintAsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s uintAsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s uintAsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
+func (s uintAsSchema[T]) TypeCheck(ctx context.Context, v any) error { return (&s.n).TypeCheck(ctx, v) }
+func (s uintAsSchema[T]) RuleCheck(ctx context.Context, v any) error { return (&s.n).RuleCheck(ctx, v) }
+func (s uintAsSchema[T]) Validate(ctx context.Context, v any) error  { return (&s.n).Validate(ctx, v) }
+func (s uintAsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, uintText(uint64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s uintAsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
+}
 
 // UintOf returns an AnyAdapter for a json.Number wire schema projected to domain type T(~uint64).
-func UintOf[T ~uint64]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](uintAsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
-}
+func UintOf[T ~uint64]() AnyAdapter { return UintOfSchema[T](NumberJSON()) }
 
 // helper to produce *float64 for JSONSchema Minimum
 func ptrFloat(v float64) *float64 { return &v }
 
+// ---------------- Int64Of[T] ----------------
+// int64AsSchema projects json.Number to domain type T with underlying int64, keeping the full
+// 64-bit range on every platform.
+type int64AsSchema[T ~int64] struct{ n numberJSONSchema }
+
+func (s int64AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
+	// Accept direct integers if in range
+	switch t := v.(type) {
+	case int, int8, int16, int32, int64:
+		return validatedNumber[T](ctx, s, T(reflect.ValueOf(t).Int()))
+	case uint, uint8, uint16, uint32, uint64:
+		u := reflect.ValueOf(t).Uint()
+		if u > math.MaxInt64 {
+			var zero T
+			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "int64 overflow"}}
+		}
+		return validatedNumber[T](ctx, s, T(int64(u)))
+	}
+	num, err := (&s.n).Parse(ctx, v)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return int64FromNumber[T](num)
+}
+
+// int64FromNumber converts an integral json.Number; out-of-range values are overflow issues.
+func int64FromNumber[T ~int64](num json.Number) (T, error) {
+	i64, err := strconv.ParseInt(num.String(), 10, 64)
+	if err != nil {
+		var zero T
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "int64 overflow"}}
+		}
+		return zero, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Cause: err}}
+	}
+	return T(i64), nil
+}
+
+func (s int64AsSchema[T]) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[T], error) {
+	tv, err := s.Parse(ctx, v)
+	if err != nil {
+		var zero goskema.Decoded[T]
+		return zero, err
+	}
+	return goskema.Decoded[T]{Value: tv, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, nil
+}
+
+// ---- streaming SPI ----
+func (s int64AsSchema[T]) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (T, error) {
+	num, err := (&s.n).ParseFromSource(ctx, src, opt)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return int64FromNumber[T](num)
+}
+func (s int64AsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[T], error) {
+	v, err := s.ParseFromSource(ctx, src, opt)
+	return goskema.Decoded[T]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
+}
+
+func (s int64AsSchema[T]) TypeCheck(ctx context.Context, v any) error {
+	return (&s.n).TypeCheck(ctx, v)
+}
+func (s int64AsSchema[T]) RuleCheck(ctx context.Context, v any) error {
+	return (&s.n).RuleCheck(ctx, v)
+}
+func (s int64AsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s int64AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, intText(int64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s int64AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
+}
+
+// Int64Of returns an AnyAdapter for a json.Number wire schema projected to domain type T(~int64).
+func Int64Of[T ~int64]() AnyAdapter { return Int64OfSchema[T](NumberJSON()) }
+
 // ---------------- Int32Of[T] ----------------
 // int32AsSchema projects json.Number to domain type T with underlying int32.
 type int32AsSchema[T ~int32] struct{ n numberJSONSchema }
@@ -604,7 +712,7 @@ func (s int32AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 			var zero T
 			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "int32 overflow"}}
 		}
-		return T(int32(i64)), nil
+		return validatedNumber[T](ctx, s, T(int32(i64)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -674,16 +782,19 @@ func (s int32AsSchema[T]) TypeCheck(ctx context.Context, v any) error {
 func (s int32AsSchema[T]) RuleCheck(ctx context.Context, v any) error {
 	return (&s.n).RuleCheck(ctx, v)
 }
-func (s int32AsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s int32AsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s int32AsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
+func (s int32AsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s int32AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, intText(int64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s int32AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
+}
 
 // Int32Of returns an AnyAdapter for a json.Number wire schema projected to domain type T(~int32).
-func Int32Of[T ~int32]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](int32AsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
-}
+func Int32Of[T ~int32]() AnyAdapter { return Int32OfSchema[T](NumberJSON()) }
 
 // ---------------- Uint32Of[T] ----------------
 // uint32AsSchema projects json.Number to domain type T with underlying uint32.
@@ -697,7 +808,7 @@ func (s uint32AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 			var zero T
 			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "uint32 overflow"}}
 		}
-		return T(uint32(u64)), nil
+		return validatedNumber[T](ctx, s, T(uint32(u64)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -754,16 +865,19 @@ func (s uint32AsSchema[T]) TypeCheck(ctx context.Context, v any) error {
 func (s uint32AsSchema[T]) RuleCheck(ctx context.Context, v any) error {
 	return (&s.n).RuleCheck(ctx, v)
 }
-func (s uint32AsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s uint32AsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s uint32AsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
+func (s uint32AsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s uint32AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, uintText(uint64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s uint32AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
+}
 
 // Uint32Of returns an AnyAdapter for a json.Number wire schema projected to domain type T(~uint32).
-func Uint32Of[T ~uint32]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](uint32AsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
-}
+func Uint32Of[T ~uint32]() AnyAdapter { return Uint32OfSchema[T](NumberJSON()) }
 
 // ---------------- Int16Of[T] ----------------
 type int16AsSchema[T ~int16] struct{ n numberJSONSchema }
@@ -776,7 +890,7 @@ func (s int16AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 			var zero T
 			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "int16 overflow"}}
 		}
-		return T(int16(i64)), nil
+		return validatedNumber[T](ctx, s, T(int16(i64)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -844,16 +958,19 @@ func (s int16AsSchema[T]) TypeCheck(ctx context.Context, v any) error {
 func (s int16AsSchema[T]) RuleCheck(ctx context.Context, v any) error {
 	return (&s.n).RuleCheck(ctx, v)
 }
-func (s int16AsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s int16AsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s int16AsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
-
-func Int16Of[T ~int16]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](int16AsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
+func (s int16AsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s int16AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, intText(int64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s int16AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
 }
 
+func Int16Of[T ~int16]() AnyAdapter { return Int16OfSchema[T](NumberJSON()) }
+
 // ---------------- Uint16Of[T] ----------------
 type uint16AsSchema[T ~uint16] struct{ n numberJSONSchema }
 
@@ -865,7 +982,7 @@ func (s uint16AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 			var zero T
 			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "uint16 overflow"}}
 		}
-		return T(uint16(u64)), nil
+		return validatedNumber[T](ctx, s, T(uint16(u64)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -922,15 +1039,18 @@ func (s uint16AsSchema[T]) TypeCheck(ctx context.Context, v any) error {
 func (s uint16AsSchema[T]) RuleCheck(ctx context.Context, v any) error {
 	return (&s.n).RuleCheck(ctx, v)
 }
-func (s uint16AsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s uint16AsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s uint16AsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
-
-func Uint16Of[T ~uint16]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](uint16AsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
+func (s uint16AsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s uint16AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, uintText(uint64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
 }
+func (s uint16AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
+}
+
+func Uint16Of[T ~uint16]() AnyAdapter { return Uint16OfSchema[T](NumberJSON()) }
 
 // ---------------- Int8Of[T] ----------------
 type int8AsSchema[T ~int8] struct{ n numberJSONSchema }
@@ -943,7 +1063,7 @@ func (s int8AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 			var zero T
 			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "int8 overflow"}}
 		}
-		return T(int8(i64)), nil
+		return validatedNumber[T](ctx, s, T(int8(i64)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -1005,18 +1125,21 @@ func (s int8AsSchema[T]) ParseFromSourceWithMeta(ctx context.Context, src goskem
 	return goskema.Decoded[T]{Value: v, Presence: goskema.PresenceMap{"/": goskema.PresenceSeen}}, err
 }
 
-func (s int8AsSchema[T]) TypeCheck(ctx context.Context, v any) error   { return (&s.n).TypeCheck(ctx, v) }
-func (s int8AsSchema[T]) RuleCheck(ctx context.Context, v any) error   { return (&s.n).RuleCheck(ctx, v) }
-func (s int8AsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s int8AsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s int8AsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
-
-func Int8Of[T ~int8]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](int8AsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
+func (s int8AsSchema[T]) TypeCheck(ctx context.Context, v any) error { return (&s.n).TypeCheck(ctx, v) }
+func (s int8AsSchema[T]) RuleCheck(ctx context.Context, v any) error { return (&s.n).RuleCheck(ctx, v) }
+func (s int8AsSchema[T]) Validate(ctx context.Context, v any) error  { return (&s.n).Validate(ctx, v) }
+func (s int8AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, intText(int64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s int8AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
 }
 
+func Int8Of[T ~int8]() AnyAdapter { return Int8OfSchema[T](NumberJSON()) }
+
 // ---------------- Uint8Of[T] ----------------
 type uint8AsSchema[T ~uint8] struct{ n numberJSONSchema }
 
@@ -1028,7 +1151,7 @@ func (s uint8AsSchema[T]) Parse(ctx context.Context, v any) (T, error) {
 			var zero T
 			return zero, goskema.Issues{{Path: "/", Code: goskema.CodeOverflow, Message: "uint8 overflow"}}
 		}
-		return T(uint8(u64)), nil
+		return validatedNumber[T](ctx, s, T(uint8(u64)))
 	}
 	num, err := (&s.n).Parse(ctx, v)
 	if err != nil {
@@ -1085,16 +1208,19 @@ func (s uint8AsSchema[T]) TypeCheck(ctx context.Context, v any) error {
 func (s uint8AsSchema[T]) RuleCheck(ctx context.Context, v any) error {
 	return (&s.n).RuleCheck(ctx, v)
 }
-func (s uint8AsSchema[T]) Validate(ctx context.Context, v any) error    { return (&s.n).Validate(ctx, v) }
-func (s uint8AsSchema[T]) ValidateValue(ctx context.Context, v T) error { return nil }
-func (s uint8AsSchema[T]) JSONSchema() (*js.Schema, error)              { return &js.Schema{Type: "integer"}, nil }
-
-func Uint8Of[T ~uint8]() AnyAdapter {
-	ad := anyAdapterFromSchema[T](uint8AsSchema[T]{})
-	ad.orig = numberJSONSchema{}
-	return ad
+func (s uint8AsSchema[T]) Validate(ctx context.Context, v any) error { return (&s.n).Validate(ctx, v) }
+func (s uint8AsSchema[T]) ValidateValue(ctx context.Context, v T) error {
+	if iss := s.n.bounds.check(ctx, uintText(uint64(v))); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+func (s uint8AsSchema[T]) JSONSchema() (*js.Schema, error) {
+	return s.n.bounds.apply(&js.Schema{Type: "integer"}), nil
 }
 
+func Uint8Of[T ~uint8]() AnyAdapter { return Uint8OfSchema[T](NumberJSON()) }
+
 func (n *numberJSONSchema) Parse(ctx context.Context, v any) (json.Number, error) {
 	switch t := v.(type) {
 	case json.Number:
@@ -1112,7 +1238,7 @@ func (n *numberJSONSchema) Parse(ctx context.Context, v any) (json.Number, error
 		}
 		return num, nil
 	case float64:
-		return json.Number(strconvFormatFloat(t)), nil
+		return n.checked(ctx, json.Number(strconvFormatFloat(t)))
 	case string:
 		if n.coerceFromString {
 			if _, err := strconv.ParseFloat(t, 64); err != nil {
@@ -1120,9 +1246,9 @@ func (n *numberJSONSchema) Parse(ctx context.Context, v any) (json.Number, error
 			}
 			// Canonicalize via float64 formatting for consistency with float64 input
 			if f, err := strconv.ParseFloat(t, 64); err == nil {
-				return json.Number(strconvFormatFloat(f)), nil
+				return n.checked(ctx, json.Number(strconvFormatFloat(f)))
 			}
-			return json.Number(t), nil
+			return n.checked(ctx, json.Number(t))
 		}
 		return json.Number(""), goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil)}}
 	default:
@@ -1148,19 +1274,19 @@ func (n *numberJSONSchema) ParseFromSource(ctx context.Context, src goskema.Sour
 		if src.NumberMode() == goskema.NumberFloat64 {
 			// format float back to canonical string to preserve contract
 			if f, perr := strconv.ParseFloat(tok.Number, 64); perr == nil {
-				return json.Number(strconvFormatFloat(f)), nil
+				return n.checked(ctx, json.Number(strconvFormatFloat(f)))
 			}
 		}
-		return json.Number(tok.Number), nil
+		return n.checked(ctx, json.Number(tok.Number))
 	case eng.KindString:
 		if n.coerceFromString {
 			if _, perr := strconv.ParseFloat(tok.String, 64); perr != nil {
 				return json.Number(""), goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Cause: perr}}
 			}
 			if f, perr := strconv.ParseFloat(tok.String, 64); perr == nil {
-				return json.Number(strconvFormatFloat(f)), nil
+				return n.checked(ctx, json.Number(strconvFormatFloat(f)))
 			}
-			return json.Number(tok.String), nil
+			return n.checked(ctx, json.Number(tok.String))
 		}
 		return json.Number(""), goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil)}}
 	default:
@@ -1187,7 +1313,15 @@ func (n *numberJSONSchema) TypeCheck(ctx context.Context, v any) error {
 	}
 }
 
-func (n *numberJSONSchema) RuleCheck(ctx context.Context, v any) error { return nil }
+func (n *numberJSONSchema) RuleCheck(ctx context.Context, v any) error {
+	switch t := v.(type) {
+	case json.Number:
+		return n.ValidateValue(ctx, t)
+	case float64:
+		return n.ValidateValue(ctx, json.Number(strconvFormatFloat(t)))
+	}
+	return nil
+}
 
 func (n *numberJSONSchema) Validate(ctx context.Context, v any) error {
 	if err := n.TypeCheck(ctx, v); err != nil {
@@ -1196,9 +1330,24 @@ func (n *numberJSONSchema) Validate(ctx context.Context, v any) error {
 	return n.RuleCheck(ctx, v)
 }
 
-func (n *numberJSONSchema) ValidateValue(ctx context.Context, v json.Number) error { return nil }
+func (n *numberJSONSchema) ValidateValue(ctx context.Context, v json.Number) error {
+	if iss := n.bounds.check(ctx, string(v)); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+
+// checked runs ValidateValue for numbers that bypass the json.Number branch of Parse.
+func (n *numberJSONSchema) checked(ctx context.Context, v json.Number) (json.Number, error) {
+	if err := n.ValidateValue(ctx, v); err != nil {
+		return json.Number(""), err
+	}
+	return v, nil
+}
 
-func (n *numberJSONSchema) JSONSchema() (*js.Schema, error) { return &js.Schema{Type: "number"}, nil }
+func (n *numberJSONSchema) JSONSchema() (*js.Schema, error) {
+	return n.bounds.apply(&js.Schema{Type: "number"}), nil
+}
 
 // strconvFormatFloat mirrors the canonical JSON-like float formatting.
 func strconvFormatFloat(f float64) string { return strconv.FormatFloat(f, 'g', -1, 64) }
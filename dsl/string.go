@@ -0,0 +1,221 @@
+package dsl
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// StringBuilder exposes chaining options for string schemas while implementing Schema[string].
+// Constraints are enforced by Parse, ParseFromSource, RuleCheck and ValidateValue alike.
+type StringBuilder interface {
+	goskema.Schema[string]
+	// MinLen sets the minimum length in runes (Unicode code points).
+	MinLen(n int) StringBuilder
+	// MaxLen sets the maximum length in runes (Unicode code points).
+	MaxLen(n int) StringBuilder
+	// Pattern requires the value to match re (unanchored, RE2 semantics).
+	Pattern(re *regexp.Regexp) StringBuilder
+	// OneOf restricts the value to the given set.
+	OneOf(values ...string) StringBuilder
+	// StartsWith requires the given prefix.
+	StartsWith(prefix string) StringBuilder
+	// EndsWith requires the given suffix.
+	EndsWith(suffix string) StringBuilder
+	// Format requires the value to satisfy a named format (e.g. "email", "uuid", "date-time").
+	// Names are resolved against the schema's registry (see Formats) or the global one;
+	// unknown names are rejected when the enclosing object is built.
+	Format(name string) StringBuilder
+	// Formats sets a per-schema format registry consulted before the global one.
+	Formats(r *goskema.FormatRegistry) StringBuilder
+}
+
+// stringSchema implements StringBuilder. Negative lengths mean "unset".
+type stringSchema struct {
+	minLen  int
+	maxLen  int
+	pattern *regexp.Regexp
+	enum    []string
+	prefix  string
+	suffix  string
+	format  string
+	formats *goskema.FormatRegistry
+}
+
+func newStringSchema() *stringSchema { return &stringSchema{minLen: -1, maxLen: -1} }
+
+// StringOfSchema converts a constrained StringBuilder into an AnyAdapter projected to domain type T.
+// sb must come from String(); other builders are reported when the enclosing object is built.
+// Example: Field("name", StringOfSchema[Name](String().MinLen(1).MaxLen(64)))
+func StringOfSchema[T ~string](sb StringBuilder) AnyAdapter {
+	inner, ok := sb.(*stringSchema)
+	var buildErr error
+	if !ok || inner == nil {
+		inner = newStringSchema()
+		buildErr = goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: i18n.T(goskema.CodeParseError, nil), Hint: "StringOfSchema requires a builder created by String()"}}
+	}
+	ad := anyAdapterFromSchema[T](stringAsSchema[T]{s: inner})
+	ad.orig = inner
+	ad.buildErr = buildErr
+	return ad
+}
+
+// MinLen sets the minimum rune length.
+func (s *stringSchema) MinLen(n int) StringBuilder { s.minLen = n; return s }
+
+// MaxLen sets the maximum rune length.
+func (s *stringSchema) MaxLen(n int) StringBuilder { s.maxLen = n; return s }
+
+// Pattern sets the regular expression the value must match.
+func (s *stringSchema) Pattern(re *regexp.Regexp) StringBuilder { s.pattern = re; return s }
+
+// OneOf restricts the value to the given set.
+func (s *stringSchema) OneOf(values ...string) StringBuilder {
+	s.enum = append([]string(nil), values...)
+	return s
+}
+
+// StartsWith requires the given prefix.
+func (s *stringSchema) StartsWith(prefix string) StringBuilder { s.prefix = prefix; return s }
+
+// EndsWith requires the given suffix.
+func (s *stringSchema) EndsWith(suffix string) StringBuilder { s.suffix = suffix; return s }
+
+// Format sets the named format the value must satisfy.
+func (s *stringSchema) Format(name string) StringBuilder { s.format = name; return s }
+
+// Formats sets the per-schema format registry.
+func (s *stringSchema) Formats(r *goskema.FormatRegistry) StringBuilder { s.formats = r; return s }
+
+// lookupFormat resolves the configured format against the per-schema registry, then the global one.
+func (s *stringSchema) lookupFormat() (goskema.FormatFunc, bool) {
+	if s.formats != nil {
+		return s.formats.Lookup(s.format)
+	}
+	return goskema.LookupFormat(s.format)
+}
+
+// checkBuild reports unknown format names when the enclosing object is built.
+func (s *stringSchema) checkBuild() error {
+	if s.format == "" {
+		return nil
+	}
+	if _, ok := s.lookupFormat(); !ok {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidFormat, Message: i18n.T(goskema.CodeInvalidFormat, nil), Hint: "unknown format: " + s.format, Params: map[string]any{"format": s.format}}}
+	}
+	return nil
+}
+
+// checkConstraints evaluates all configured constraints in a fixed order
+// (length -> prefix/suffix -> pattern -> format -> enum). In fail-fast mode it stops at the first issue.
+func (s *stringSchema) checkConstraints(ctx context.Context, v string) goskema.Issues {
+	var iss goskema.Issues
+	add := func(it goskema.Issue) bool {
+		iss = goskema.AppendIssues(iss, it)
+		return goskema.IsFailFast(ctx)
+	}
+	if s.minLen >= 0 || s.maxLen >= 0 {
+		n := utf8.RuneCountInString(v)
+		if s.minLen >= 0 && n < s.minLen {
+			if add(goskema.Issue{Path: "/", Code: goskema.CodeTooShort, Message: i18n.T(goskema.CodeTooShort, nil), Hint: "string is shorter than min", Params: map[string]any{"min": s.minLen, "got": n}}) {
+				return iss
+			}
+		}
+		if s.maxLen >= 0 && n > s.maxLen {
+			if add(goskema.Issue{Path: "/", Code: goskema.CodeTooLong, Message: i18n.T(goskema.CodeTooLong, nil), Hint: "string is longer than max", Params: map[string]any{"max": s.maxLen, "got": n}}) {
+				return iss
+			}
+		}
+	}
+	if s.prefix != "" && !strings.HasPrefix(v, s.prefix) {
+		if add(goskema.Issue{Path: "/", Code: goskema.CodePattern, Message: i18n.T(goskema.CodePattern, nil), Hint: "must start with '" + s.prefix + "'", Params: map[string]any{"prefix": s.prefix}}) {
+			return iss
+		}
+	}
+	if s.suffix != "" && !strings.HasSuffix(v, s.suffix) {
+		if add(goskema.Issue{Path: "/", Code: goskema.CodePattern, Message: i18n.T(goskema.CodePattern, nil), Hint: "must end with '" + s.suffix + "'", Params: map[string]any{"suffix": s.suffix}}) {
+			return iss
+		}
+	}
+	if s.pattern != nil && !s.pattern.MatchString(v) {
+		if add(goskema.Issue{Path: "/", Code: goskema.CodePattern, Message: i18n.T(goskema.CodePattern, nil), Hint: "must match " + s.pattern.String(), Params: map[string]any{"pattern": s.pattern.String()}}) {
+			return iss
+		}
+	}
+	if s.format != "" {
+		// Unknown formats fail closed here as well, for schemas used outside an object builder.
+		hint := "unknown format: " + s.format
+		var cause error
+		fn, ok := s.lookupFormat()
+		if ok {
+			cause = fn(v)
+			if cause != nil {
+				hint = "must be a valid " + s.format
+			}
+		}
+		if !ok || cause != nil {
+			if add(goskema.Issue{Path: "/", Code: goskema.CodeInvalidFormat, Message: i18n.T(goskema.CodeInvalidFormat, nil), Hint: hint, Cause: cause, Params: map[string]any{"format": s.format}}) {
+				return iss
+			}
+		}
+	}
+	if len(s.enum) > 0 {
+		found := false
+		for _, e := range s.enum {
+			if e == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			add(goskema.Issue{Path: "/", Code: goskema.CodeInvalidEnum, Message: i18n.T(goskema.CodeInvalidEnum, nil), Hint: "allowed: " + strings.Join(s.enum, ", "), Params: map[string]any{"allowed": append([]string(nil), s.enum...), "got": v}})
+		}
+	}
+	return iss
+}
+
+func (s *stringSchema) JSONSchema() (*js.Schema, error) {
+	out := &js.Schema{Type: "string"}
+	if s.minLen >= 0 {
+		n := s.minLen
+		out.MinLength = &n
+	}
+	if s.maxLen >= 0 {
+		n := s.maxLen
+		out.MaxLength = &n
+	}
+	// prefix/suffix are approximated with an ECMA-262 compatible pattern; with an explicit
+	// pattern as well, both must match (allOf).
+	affix := ""
+	if s.prefix != "" {
+		affix = "^" + regexp.QuoteMeta(s.prefix)
+	}
+	if s.suffix != "" {
+		if affix != "" {
+			affix += "[\\s\\S]*"
+		}
+		affix += regexp.QuoteMeta(s.suffix) + "$"
+	}
+	switch {
+	case s.pattern != nil && affix != "":
+		out.Pattern = s.pattern.String()
+		out.AllOf = []*js.Schema{{Pattern: affix}}
+	case s.pattern != nil:
+		out.Pattern = s.pattern.String()
+	default:
+		out.Pattern = affix
+	}
+	out.Format = s.format
+	if len(s.enum) > 0 {
+		out.Enum = make([]any, 0, len(s.enum))
+		for _, e := range s.enum {
+			out.Enum = append(out.Enum, e)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,123 @@
+package dsl_test
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func firstCode(t *testing.T, err error) string {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) == 0 {
+		t.Fatalf("expected Issues, got %v", err)
+	}
+	return iss[0].Code
+}
+
+func TestString_MinMaxLen_RuneAware(t *testing.T) {
+	ctx := context.Background()
+	s := g.String().MinLen(2).MaxLen(3)
+
+	if _, err := s.Parse(ctx, "日本"); err != nil {
+		t.Fatalf("2 runes should pass: %v", err)
+	}
+	if code := firstCode(t, func() error { _, err := s.Parse(ctx, "日"); return err }()); code != goskema.CodeTooShort {
+		t.Fatalf("want too_short, got %s", code)
+	}
+	_, err := s.Parse(ctx, "日本語です")
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Code != goskema.CodeTooLong || iss[0].Params["got"] != 5 || iss[0].Params["max"] != 3 {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+func TestString_PatternEnumPrefixSuffix(t *testing.T) {
+	ctx := context.Background()
+
+	pat := g.String().Pattern(regexp.MustCompile(`^[a-z]+$`))
+	if _, err := pat.Parse(ctx, "abc"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if code := firstCode(t, pat.ValidateValue(ctx, "ABC")); code != goskema.CodePattern {
+		t.Fatalf("want pattern, got %s", code)
+	}
+
+	enum := g.String().OneOf("red", "green")
+	if code := firstCode(t, enum.Validate(ctx, "blue")); code != goskema.CodeInvalidEnum {
+		t.Fatalf("want invalid_enum, got %s", code)
+	}
+
+	affix := g.String().StartsWith("sk_").EndsWith("_v1")
+	if err := affix.ValidateValue(ctx, "sk_live_v1"); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := affix.Parse(ctx, "pk_live")
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 2 || iss[0].Code != goskema.CodePattern || iss[1].Code != goskema.CodePattern {
+		t.Fatalf("want two pattern issues, got %+v", iss)
+	}
+}
+
+func TestString_Constraints_Streaming(t *testing.T) {
+	ctx := context.Background()
+	obj := g.Object().
+		Field("code", g.StringOfSchema[string](g.String().MinLen(3))).Required().
+		UnknownStrict().
+		MustBuild()
+
+	if _, err := goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"code":"abcd"}`))); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	_, err := goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"code":"ab"}`)))
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/code" || iss[0].Code != goskema.CodeTooShort {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+func TestString_JSONSchema_Constraints(t *testing.T) {
+	s, err := g.String().MinLen(1).MaxLen(8).Pattern(regexp.MustCompile(`^[a-z]+$`)).OneOf("abc", "xyz").JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema err: %v", err)
+	}
+	got := normalize(s)
+	want := normalize(map[string]any{
+		"type":      "string",
+		"minLength": 1,
+		"maxLength": 8,
+		"pattern":   "^[a-z]+$",
+		"enum":      []any{"abc", "xyz"},
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+
+	ps, _ := g.String().StartsWith("a.b").JSONSchema()
+	if ps.Pattern != `^a\.b` {
+		t.Fatalf("unexpected prefix pattern: %q", ps.Pattern)
+	}
+
+	// an explicit pattern keeps the prefix/suffix as a second pattern under allOf
+	both, _ := g.String().Pattern(regexp.MustCompile(`^[a-z_]+$`)).StartsWith("sk_").EndsWith("_v1").JSONSchema()
+	if both.Pattern != `^[a-z_]+$` || len(both.AllOf) != 1 || both.AllOf[0].Pattern != `^sk_[\s\S]*_v1$` {
+		t.Fatalf("unexpected patterns: %q %+v", both.Pattern, both.AllOf)
+	}
+}
+
+// foreignString is a StringBuilder that does not come from String().
+type foreignString struct{ g.StringBuilder }
+
+func TestStringOfSchema_ForeignBuilderFailsBuild(t *testing.T) {
+	_, err := g.Object().Field("name", g.StringOfSchema[string](foreignString{g.String()})).Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/name" {
+		t.Fatalf("expected a build issue at /name, got %v", err)
+	}
+}
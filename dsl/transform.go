@@ -0,0 +1,142 @@
+package dsl
+
+import (
+	"context"
+	"reflect"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+)
+
+// Preprocess runs fn on the raw wire value before the adapter parses it, i.e. ahead of the
+// field schema's Coerce -> Normalize -> Validate -> Refine (trim a string, parse "1,234",
+// wrap a single value into an array). Errors are reported at the field's JSON Pointer;
+// returning goskema.Issues keeps their codes. In streaming parses the field value is decoded
+// first and then preprocessed.
+// Example: Field("email", g.StringOf[string]().Preprocess(trimLower))
+func (ad AnyAdapter) Preprocess(fn func(any) (any, error)) AnyAdapter {
+	if fn == nil {
+		return ad
+	}
+	return ad.preStage("preprocess", goskema.PresenceTransformed, fn)
+}
+
+// preStage wraps ad so that fn rewrites the raw input before parsing; flag is recorded in the
+// value's presence when fn changed it.
+func (ad AnyAdapter) preStage(stage string, flag goskema.Presence, fn func(any) (any, error)) AnyAdapter {
+	prev, inner := ad.parse, ad.staged
+	out := ad
+	out.staged = true
+	out.parseFromSource = nil
+	out.parse = func(ctx context.Context, v any) (any, error) {
+		pv, err := fn(v)
+		if err != nil {
+			return nil, stageIssues(stage, err)
+		}
+		if mark := stageMark(ctx); mark != nil && !reflect.DeepEqual(pv, v) {
+			*mark |= flag
+		}
+		if prev == nil {
+			return pv, nil
+		}
+		return prev(stageInnerCtx(ctx, inner), pv)
+	}
+	return out.withRewrap(func(x AnyAdapter) AnyAdapter { return x.preStage(stage, flag, fn) })
+}
+
+// Transform runs fn on the parsed value, after the field schema's Coerce -> Normalize ->
+// Validate -> Refine and before the enclosing object's own Normalize/Refine. Errors are reported
+// at the field's JSON Pointer. Transform is a function rather than a method because it is
+// generic in T; nil values (Nullable) are passed through untouched. A T that the adapter cannot
+// produce is reported by Build, and parsed values of another type are invalid_type issues.
+// Example: Transform(g.StringOf[string](), func(s string) (string, error) { return strings.ToLower(s), nil })
+func Transform[T any](ad AnyAdapter, fn func(T) (T, error)) AnyAdapter {
+	if fn == nil {
+		return ad
+	}
+	want := reflect.TypeOf((*T)(nil)).Elem()
+	apply := func(ctx context.Context, v any) (any, error) {
+		if v == nil {
+			return nil, nil
+		}
+		tv, ok := v.(T)
+		if !ok {
+			return nil, goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "transform expects " + want.String() + ", got " + reflect.TypeOf(v).String()}}
+		}
+		nv, err := fn(tv)
+		if err != nil {
+			return nil, stageIssues("transform", err)
+		}
+		if mark := stageMark(ctx); mark != nil && !reflect.DeepEqual(any(nv), v) {
+			*mark |= goskema.PresenceTransformed
+		}
+		return nv, nil
+	}
+	prev, inner := ad.parse, ad.staged
+	out := ad
+	out.staged = true
+	// interface outputs (unions, MapAny) hold values of several types; those are checked per value
+	if ad.out != nil && ad.out.Kind() != reflect.Interface && !ad.out.AssignableTo(want) && out.buildErr == nil {
+		out.buildErr = goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "Transform expects " + want.String() + " but the schema produces " + ad.out.String()}}
+	}
+	out.parse = func(ctx context.Context, v any) (any, error) {
+		pv := v
+		if prev != nil {
+			var err error
+			if pv, err = prev(stageInnerCtx(ctx, inner), v); err != nil {
+				return nil, err
+			}
+		}
+		return apply(ctx, pv)
+	}
+	if prevSrc := ad.parseFromSource; prevSrc != nil {
+		out.parseFromSource = func(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (any, error) {
+			pv, err := prevSrc(stageInnerCtx(ctx, inner), src, opt)
+			if err != nil {
+				return nil, err
+			}
+			return apply(ctx, pv)
+		}
+	}
+	return out.withRewrap(func(x AnyAdapter) AnyAdapter { return Transform(x, fn) })
+}
+
+// stageIssues places a stage error at the value root so that containers rebase it under the
+// field (or element) path.
+func stageIssues(stage string, err error) error {
+	if iss, ok := goskema.AsIssues(err); ok {
+		return iss
+	}
+	return goskema.Issues{goskema.Issue{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Hint: stage + " failed", Cause: err}}
+}
+
+// stageMarkKey carries a *goskema.Presence into which stages OR the flags describing how they
+// changed the value (PresenceTransformed, PresenceCoerced). Containers install one per staged
+// child (see handleExistingField).
+type stageMarkKey struct{}
+
+func stageMark(ctx context.Context) *goskema.Presence {
+	m, _ := ctx.Value(stageMarkKey{}).(*goskema.Presence)
+	return m
+}
+
+// stageInnerCtx is the context for the parse wrapped by a stage: the mark is kept when the
+// wrapped adapter is itself staged (chained stages of one value) and hidden otherwise, so that
+// stages nested inside the wrapped schema do not report on behalf of this value.
+func stageInnerCtx(ctx context.Context, staged bool) context.Context {
+	if staged || stageMark(ctx) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, stageMarkKey{}, (*goskema.Presence)(nil))
+}
+
+// parseTracked parses val with ad and returns the presence flags recorded by its stages.
+func (ad AnyAdapter) parseTracked(ctx context.Context, val any) (any, goskema.Presence, error) {
+	if !ad.staged {
+		v, err := ad.parse(ctx, val)
+		return v, 0, err
+	}
+	var flags goskema.Presence
+	v, err := ad.parse(context.WithValue(ctx, stageMarkKey{}, &flags), val)
+	return v, flags, err
+}
@@ -0,0 +1,214 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func trimLower(v any) (any, error) {
+	if s, ok := v.(string); ok {
+		return strings.ToLower(strings.TrimSpace(s)), nil
+	}
+	return v, nil
+}
+
+func stripThousands(v any) (any, error) {
+	if s, ok := v.(string); ok {
+		return json.Number(strings.ReplaceAll(s, ",", "")), nil
+	}
+	return v, nil
+}
+
+func wrapScalar(v any) (any, error) {
+	if _, ok := v.([]any); ok || v == nil {
+		return v, nil
+	}
+	return []any{v}, nil
+}
+
+func TestPreprocess_RunsBeforeFieldValidation(t *testing.T) {
+	s := g.Object().
+		Field("email", g.SchemaOf[string](g.String().Format("email")).Preprocess(trimLower)).Required().
+		Field("amount", g.SchemaOf[json.Number](g.NumberJSON().Min(json.Number("1000"))).Preprocess(stripThousands)).
+		Field("tags", g.ArrayOf[string](g.String()).Preprocess(wrapScalar)).
+		MustBuild()
+
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"email":"  Alice@Example.COM ","amount":"1,234","tags":"solo"}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	v := dm.Value
+	if v["email"] != "alice@example.com" || v["amount"] != json.Number("1234") {
+		t.Fatalf("unexpected values: %#v", v)
+	}
+	if tags, ok := v["tags"].([]string); !ok || len(tags) != 1 || tags[0] != "solo" {
+		t.Fatalf("scalar not wrapped: %#v", v["tags"])
+	}
+	for _, p := range []string{"/email", "/amount", "/tags"} {
+		if dm.Presence[p]&goskema.PresenceTransformed == 0 || dm.Presence[p]&goskema.PresenceSeen == 0 {
+			t.Errorf("%s: expected seen+transformed, got %v", p, dm.Presence[p])
+		}
+	}
+
+	// unchanged input does not record a transformation
+	dm, err = goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"email":"bob@example.com"}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Presence["/email"]&goskema.PresenceTransformed != 0 {
+		t.Fatalf("unexpected transformed flag: %v", dm.Presence)
+	}
+}
+
+func TestPreprocess_ErrorAtFieldPointer(t *testing.T) {
+	s := g.Object().
+		Field("n", g.SchemaOf[json.Number](g.NumberJSON()).Preprocess(func(v any) (any, error) {
+			return nil, errors.New("not a number")
+		})).
+		MustBuild()
+	_, err := s.Parse(context.Background(), map[string]any{"n": "x"})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Path != "/n" || iss[0].Hint != "preprocess failed" {
+		t.Fatalf("expected error at /n, got %v", err)
+	}
+}
+
+func TestTransform_AfterFieldParse(t *testing.T) {
+	collapse := g.Transform(g.StringOf[string](), func(s string) (string, error) {
+		return strings.Join(strings.Fields(s), " "), nil
+	})
+	type doc struct {
+		Title string `json:"title"`
+		Slug  string `json:"slug"`
+	}
+	s := g.ObjectOf[doc]().
+		Field("title", collapse).Required().
+		Field("slug", g.Transform(g.StringOf[string](), func(s string) (string, error) {
+			if strings.Contains(s, " ") {
+				return "", goskema.Issues{{Path: "/", Code: goskema.CodeInvalidFormat, Message: "slug must not contain spaces"}}
+			}
+			return s, nil
+		})).
+		MustBind()
+
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"title":"  a   b  ","slug":"a-b"}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Value.Title != "a b" || dm.Presence["/title"]&goskema.PresenceTransformed == 0 || dm.Presence["/slug"]&goskema.PresenceTransformed != 0 {
+		t.Fatalf("unexpected result: %+v %v", dm.Value, dm.Presence)
+	}
+
+	_, err = s.Parse(context.Background(), map[string]any{"title": "x", "slug": "a b"})
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Path != "/slug" || iss[0].Code != goskema.CodeInvalidFormat {
+		t.Fatalf("expected invalid_format at /slug, got %v", err)
+	}
+}
+
+func TestTransform_ChainedWithPreprocessAndNullable(t *testing.T) {
+	ad := g.Transform(g.StringOf[string]().Preprocess(trimLower), func(s string) (string, error) {
+		return s + "!", nil
+	}).Nullable()
+	s := g.Object().Field("a", ad).Field("b", ad).MustBuild()
+	dm, err := goskema.ParseFromWithMeta(context.Background(), s, goskema.JSONBytes([]byte(`{"a":" HI ","b":null}`)))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if dm.Value["a"] != "hi!" || dm.Value["b"] != nil {
+		t.Fatalf("unexpected values: %#v", dm.Value)
+	}
+	if dm.Presence["/a"]&goskema.PresenceTransformed == 0 || dm.Presence["/b"]&goskema.PresenceTransformed != 0 {
+		t.Fatalf("unexpected presence: %v", dm.Presence)
+	}
+}
+
+func TestPreprocess_UnderAllOfRecordsTransformed(t *testing.T) {
+	ctx := context.Background()
+	a := g.Object().Field("email", g.StringOf[string]().Preprocess(trimLower)).MustBuild()
+	b := g.Object().Field("email", g.SchemaOf[string](g.String().MinLen(3))).MustBuild()
+	s := g.AllOf(a, b).MustBuild()
+
+	dm, err := goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"email":" A@B.C "}`)))
+	if err != nil || dm.Value["email"] != "a@b.c" {
+		t.Fatalf("unexpected: %#v %v", dm.Value, err)
+	}
+	if dm.Presence["/email"]&goskema.PresenceTransformed == 0 {
+		t.Fatalf("expected PresenceTransformed under AllOf, got %b", dm.Presence["/email"])
+	}
+
+	// identical definitions keep the stages of the second part as well
+	c := g.Object().Field("email", g.StringOf[string]()).MustBuild()
+	s = g.AllOf(c, a).MustBuild()
+	dm, err = goskema.ParseFromWithMeta(ctx, s, goskema.JSONBytes([]byte(`{"email":" A@B.C "}`)))
+	if err != nil || dm.Presence["/email"]&goskema.PresenceTransformed == 0 {
+		t.Fatalf("stages of the second part lost: %#v %b %v", dm.Value, dm.Presence["/email"], err)
+	}
+}
+
+func TestPreprocess_KeptByDeepPartial(t *testing.T) {
+	ctx := context.Background()
+	spec := g.Object().
+		Field("image", g.StringOf[string]()).Required().
+		Field("replicas", g.IntOf[int]()).Required().
+		MustBuild()
+	imageOnly := func(v any) (any, error) {
+		if s, ok := v.(string); ok {
+			return map[string]any{"image": s, "replicas": json.Number("1")}, nil
+		}
+		return v, nil
+	}
+	base := g.Object().
+		Field("spec", g.SchemaOf[map[string]any](spec).Preprocess(imageOnly)).Required().
+		Field("specs", g.Transform(g.ArrayOf[map[string]any](spec), func(v []map[string]any) ([]map[string]any, error) {
+			return append(v, map[string]any{"image": "sidecar"}), nil
+		})).
+		MustBuild()
+	patch := g.Derive(base).DeepPartial().MustBuild()
+
+	dm, err := goskema.ParseFromWithMeta(ctx, patch, goskema.JSONBytes([]byte(`{"spec":"nginx","specs":[{}]}`)))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got := dm.Value["spec"].(map[string]any)["image"]; got != "nginx" {
+		t.Fatalf("preprocess dropped by DeepPartial: %#v", dm.Value)
+	}
+	if got := dm.Value["specs"].([]map[string]any); len(got) != 2 {
+		t.Fatalf("transform dropped by DeepPartial: %#v", dm.Value)
+	}
+	if dm.Presence["/spec"]&goskema.PresenceTransformed == 0 {
+		t.Fatalf("expected PresenceTransformed at /spec, got %b", dm.Presence["/spec"])
+	}
+	// nested fields are optional
+	if _, err := patch.Parse(ctx, map[string]any{"spec": map[string]any{}}); err != nil {
+		t.Fatalf("nested fields should be optional: %v", err)
+	}
+}
+
+func TestTransform_TypeMismatch(t *testing.T) {
+	upper := func(s string) (string, error) { return strings.ToUpper(s), nil }
+
+	// the schema produces int, so a string transform is rejected when the object is built
+	_, err := g.Object().Field("n", g.Transform(g.IntOf[int](), upper)).Build()
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/n" || iss[0].Code != goskema.CodeInvalidType {
+		t.Fatalf("expected invalid_type at /n from Build, got %v", err)
+	}
+
+	// a union may produce a string or an int; an int is an invalid_type issue, not a silent no-op
+	s := g.Object().
+		Field("v", g.Transform(g.UnionOfSchema(g.Union(g.StringOf[string](), g.IntOf[int]())), upper)).
+		MustBuild()
+	if v, err := s.Parse(context.Background(), map[string]any{"v": "a"}); err != nil || v["v"] != "A" {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	_, err = s.Parse(context.Background(), map[string]any{"v": 1})
+	if iss, ok := goskema.AsIssues(err); !ok || iss[0].Path != "/v" || iss[0].Code != goskema.CodeInvalidType {
+		t.Fatalf("expected invalid_type at /v, got %v", err)
+	}
+}
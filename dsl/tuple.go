@@ -0,0 +1,303 @@
+package dsl
+
+import (
+	"context"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	eng "github.com/reoring/goskema/internal/engine"
+	str "github.com/reoring/goskema/internal/stream"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// TupleBuilder exposes chaining methods for positional (tuple) arrays while implementing Schema[[]any].
+type TupleBuilder interface {
+	goskema.Schema[[]any]
+	// Rest allows elements beyond the fixed positions, each validated by elem.
+	Rest(elem AnyAdapter) TupleBuilder
+}
+
+// Tuple returns a positional array schema: element i is validated by items[i].
+// Without Rest, the array must have exactly len(items) elements.
+// Example: Tuple(FloatOf[float64](), FloatOf[float64]()) for [lat, lng].
+func Tuple(items ...AnyAdapter) TupleBuilder {
+	return &tupleSchema{items: append([]AnyAdapter(nil), items...)}
+}
+
+// TupleOfSchema converts a TupleBuilder into an AnyAdapter for use in object builders.
+func TupleOfSchema(tb TupleBuilder) AnyAdapter { return anyAdapterFromSchema[[]any](tb) }
+
+type tupleSchema struct {
+	items []AnyAdapter
+	rest  *AnyAdapter
+}
+
+// Rest sets the schema for elements after the fixed positions.
+func (t *tupleSchema) Rest(elem AnyAdapter) TupleBuilder { t.rest = &elem; return t }
+
+// checkBuild delegates build-time checks to the positional and rest adapters.
+func (t *tupleSchema) checkBuild() error {
+	var iss goskema.Issues
+	for i, ad := range t.items {
+		if err := checkAdapterBuild(ad); err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+strconv.Itoa(i), issuesFromErr("/", err))...)
+		}
+	}
+	if t.rest != nil {
+		if err := checkAdapterBuild(*t.rest); err != nil {
+			iss = goskema.AppendIssues(iss, issuesFromErr("/", err)...)
+		}
+	}
+	if len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+
+// adapterAt returns the adapter for index i, or false when i is beyond the tuple and no Rest is set.
+func (t *tupleSchema) adapterAt(i int) (AnyAdapter, bool) {
+	if i < len(t.items) {
+		return t.items[i], true
+	}
+	if t.rest != nil {
+		return *t.rest, true
+	}
+	return AnyAdapter{}, false
+}
+
+// lengthIssues reports too_short/too_long for an array of n elements.
+func (t *tupleSchema) lengthIssues(n int) goskema.Issues {
+	if n < len(t.items) {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeTooShort, Message: i18n.T(goskema.CodeTooShort, nil), Hint: "tuple is missing elements", Params: map[string]any{"min": len(t.items), "got": n}}}
+	}
+	if t.rest == nil && n > len(t.items) {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeTooLong, Message: i18n.T(goskema.CodeTooLong, nil), Hint: "tuple has extra elements", Params: map[string]any{"max": len(t.items), "got": n}}}
+	}
+	return nil
+}
+
+func (t *tupleSchema) Parse(ctx context.Context, v any) ([]any, error) {
+	src, ok := v.([]any)
+	if !ok {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected array"}}
+	}
+	iss := t.lengthIssues(len(src))
+	if len(iss) > 0 && goskema.IsFailFast(ctx) {
+		return nil, iss
+	}
+	out := make([]any, 0, len(src))
+	for i := range src {
+		ad, ok := t.adapterAt(i)
+		if !ok {
+			break
+		}
+		ev, err := ad.parse(scopeAtIndex(ctx, i), src[i])
+		if err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+strconv.Itoa(i), issuesFromErr("/", err))...)
+			if goskema.IsFailFast(ctx) {
+				return nil, iss
+			}
+			continue
+		}
+		out = append(out, ev)
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return t.finish(ctx, out)
+}
+
+// finish applies Normalize/Refine hooks after elements have been parsed.
+func (t *tupleSchema) finish(ctx context.Context, out []any) ([]any, error) {
+	nn, err := goskema.ApplyNormalize[[]any](ctx, out, t)
+	if err != nil {
+		return nil, err
+	}
+	if err := goskema.ApplyRefine[[]any](ctx, nn, t); err != nil {
+		return nil, err
+	}
+	return nn, nil
+}
+
+func (t *tupleSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[[]any], error) {
+	pm := goskema.PresenceMap{"/": goskema.PresenceSeen}
+	if src, ok := v.([]any); ok {
+		for i := range src {
+			p := "/" + strconv.Itoa(i)
+			pm[p] |= goskema.PresenceSeen
+			if src[i] == nil {
+				pm[p] |= goskema.PresenceWasNull
+			}
+		}
+	}
+	return parseWithPresence(ctx, pm, func(ctx context.Context) ([]any, error) { return t.Parse(ctx, v) })
+}
+
+// ---- streaming SPI ----
+func (t *tupleSchema) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) ([]any, error) {
+	return t.parseFromSource(ctx, src, opt, nil)
+}
+
+func (t *tupleSchema) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[[]any], error) {
+	pm := goskema.PresenceMap{"/": goskema.PresenceSeen}
+	return parseWithPresence(ctx, pm, func(ctx context.Context) ([]any, error) { return t.parseFromSource(ctx, src, opt, pm) })
+}
+
+// parseFromSource streams the tuple element by element; only the current element is materialized.
+// When pm is non-nil, per-index presence is recorded.
+func (t *tupleSchema) parseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt, pm goskema.PresenceMap) ([]any, error) {
+	engSrc := goskema.EngineTokenSource(src)
+	var collected []eng.SimpleIssue
+	dup := eng.DupIgnore
+	switch opt.Strictness.OnDuplicateKey {
+	case goskema.Error:
+		dup = eng.DupError
+	case goskema.Warn:
+		dup = eng.DupWarn
+	}
+	enforced := eng.WrapWithEnforcement(engSrc, eng.EnforceOptions{
+		OnDuplicate: dup,
+		MaxDepth:    opt.MaxDepth,
+		MaxBytes:    opt.MaxBytes,
+		IssueSink: func(si eng.SimpleIssue) {
+			collected = append(collected, si)
+		},
+		FailFast: opt.FailFast,
+	})
+
+	tok, err := enforced.NextToken()
+	if err != nil {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+	}
+	if tok.Kind != eng.KindBeginArray {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected array"}}
+	}
+
+	var out []any
+	var iss goskema.Issues
+	idx := 0
+	for {
+		tk, err := enforced.NextToken()
+		if err != nil {
+			return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+		}
+		if tk.Kind == eng.KindEndArray {
+			break
+		}
+		path := "/" + strconv.Itoa(idx)
+		if pm != nil {
+			pm[path] |= goskema.PresenceSeen
+			if tk.Kind == eng.KindNull {
+				pm[path] |= goskema.PresenceWasNull
+			}
+		}
+		ad, ok := t.adapterAt(idx)
+		if !ok {
+			// extra element without Rest: skip its subtree; the length issue is reported below
+			_, _ = eng.DecodeAnyFromSource(str.NewPreloadedSource(enforced, tk))
+			idx++
+			continue
+		}
+		ev, i2 := parseAdapterFromSource(scopeAtIndex(ctx, idx), src, enforced, tk, ad, opt, path)
+		if len(i2) > 0 {
+			iss = goskema.AppendIssues(iss, i2...)
+			if goskema.IsFailFast(ctx) {
+				return nil, iss
+			}
+		} else {
+			out = append(out, ev)
+		}
+		idx++
+	}
+	iss = goskema.AppendIssues(iss, t.lengthIssues(idx)...)
+	if len(collected) > 0 && !goskema.IsFailFast(ctx) {
+		for _, si := range collected {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: si.Path, Code: si.Code, Message: si.Message})
+		}
+	}
+	if len(iss) > 0 {
+		return nil, iss
+	}
+	return t.finish(ctx, out)
+}
+
+func (t *tupleSchema) TypeCheck(ctx context.Context, v any) error {
+	if _, ok := v.([]any); !ok {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "expected array"}}
+	}
+	return nil
+}
+
+func (t *tupleSchema) RuleCheck(ctx context.Context, v any) error {
+	arr, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	if iss := t.lengthIssues(len(arr)); len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+
+func (t *tupleSchema) Validate(ctx context.Context, v any) error {
+	if err := t.TypeCheck(ctx, v); err != nil {
+		return err
+	}
+	return t.RuleCheck(ctx, v)
+}
+
+func (t *tupleSchema) ValidateValue(ctx context.Context, v []any) error {
+	iss := t.lengthIssues(len(v))
+	for i := range v {
+		ad, ok := t.adapterAt(i)
+		if !ok {
+			break
+		}
+		if ad.validateValue == nil {
+			continue
+		}
+		if err := ad.validateValue(ctx, v[i]); err != nil {
+			iss = goskema.AppendIssues(iss, rebaseIssuesUnder("/"+strconv.Itoa(i), issuesFromErr("/", err))...)
+			if goskema.IsFailFast(ctx) {
+				break
+			}
+		}
+	}
+	if len(iss) > 0 {
+		return iss
+	}
+	return nil
+}
+
+// JSONSchema exports JSON Schema 2020-12 prefixItems; items is the Rest schema or false.
+func (t *tupleSchema) JSONSchema() (*js.Schema, error) { return t.exportJSONSchema(&jsonExport{}) }
+
+func (t *tupleSchema) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	s := &js.Schema{Type: "array"}
+	for _, ad := range t.items {
+		es := &js.Schema{}
+		if ad.jsonSchema != nil {
+			var err error
+			if es, err = ad.jsonSchema(ex); err != nil {
+				return nil, err
+			}
+		}
+		s.PrefixItems = append(s.PrefixItems, es)
+	}
+	if t.rest != nil {
+		s.Items = &js.Schema{}
+		if t.rest.jsonSchema != nil {
+			rs, err := t.rest.jsonSchema(ex)
+			if err != nil {
+				return nil, err
+			}
+			s.Items = rs
+		}
+	} else {
+		s.Items = js.False()
+	}
+	n := len(t.items)
+	s.MinItems = &n
+	return s, nil
+}
@@ -0,0 +1,85 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestTuple_PerIndexIssues(t *testing.T) {
+	ctx := context.Background()
+	latLng := g.Tuple(
+		g.FloatOfSchema[float64](g.NumberJSON().Min("-90").Max("90")),
+		g.FloatOfSchema[float64](g.NumberJSON().Min("-180").Max("180")),
+	)
+
+	v, err := latLng.Parse(ctx, []any{json.Number("35.6"), json.Number("139.7")})
+	if err != nil || !reflect.DeepEqual(v, []any{35.6, 139.7}) {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+
+	_, err = latLng.Parse(ctx, []any{json.Number("91"), "east"})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 2 || iss[0].Path != "/0" || iss[0].Code != goskema.CodeTooBig || iss[1].Path != "/1" || iss[1].Code != goskema.CodeInvalidType {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	if code := firstCode(t, latLng.Validate(ctx, []any{json.Number("1")})); code != goskema.CodeTooShort {
+		t.Fatalf("want too_short, got %s", code)
+	}
+	if code := firstCode(t, latLng.Validate(ctx, []any{json.Number("1"), json.Number("2"), json.Number("3")})); code != goskema.CodeTooLong {
+		t.Fatalf("want too_long, got %s", code)
+	}
+}
+
+func TestTuple_Rest_Streaming(t *testing.T) {
+	ctx := context.Background()
+	row := g.Tuple(g.StringOf[string](), g.IntOf[int]()).Rest(g.BoolOf[bool]())
+	obj := g.Object().
+		Field("row", g.TupleOfSchema(row)).Required().
+		UnknownStrict().
+		MustBuild()
+
+	v, err := goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"row":["op",1,true,false]}`)))
+	if err != nil || !reflect.DeepEqual(v["row"], []any{"op", 1, true, false}) {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+
+	_, err = goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"row":["op",1,"x"]}`)))
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/row/2" || iss[0].Code != goskema.CodeInvalidType {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	_, err = goskema.ParseFrom(ctx, g.Tuple(g.StringOf[string]()), goskema.JSONBytes([]byte(`["a",{"b":1}]`)))
+	iss, _ = goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/" || iss[0].Code != goskema.CodeTooLong {
+		t.Fatalf("unexpected issues for extra element: %+v", iss)
+	}
+}
+
+func TestTuple_JSONSchema_PrefixItems(t *testing.T) {
+	s, err := g.Tuple(g.StringOf[string](), g.IntOf[int]()).JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema err: %v", err)
+	}
+	got := normalize(s)
+	want := normalize(map[string]any{
+		"type":        "array",
+		"prefixItems": []any{map[string]any{"type": "string"}, map[string]any{"type": "integer"}},
+		"items":       false,
+		"minItems":    2,
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+
+	rs, _ := g.Tuple(g.StringOf[string]()).Rest(g.BoolOf[bool]()).JSONSchema()
+	if rs.Items == nil || rs.Items.Type != "boolean" {
+		t.Fatalf("rest schema not exported: %+v", rs.Items)
+	}
+}
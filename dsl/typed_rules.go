@@ -20,6 +20,13 @@ type typedRuleE[T any] struct {
 	opt  goskema.RefineOpt[T]
 }
 
+// referencedPaths returns the JSON Pointers the rule is gated on (used by derivations to
+// reject rules whose fields were dropped).
+func (r typedRule[T]) referencedPaths() []string  { return r.opt.WhenSeen }
+func (r typedRuleE[T]) referencedPaths() []string { return r.opt.WhenSeen }
+func (r typedRule[T]) ruleName() string           { return r.name }
+func (r typedRuleE[T]) ruleName() string          { return r.name }
+
 func shouldRunRule[T any](v T, pres goskema.PresenceMap, opt goskema.RefineOpt[T]) bool {
 	// presence gating
 	if len(opt.WhenSeen) > 0 {
@@ -56,7 +63,8 @@ func shouldRunRule[T any](v T, pres goskema.PresenceMap, opt goskema.RefineOpt[T
 func runTypedRules[T any](ctx context.Context, v T, pres goskema.PresenceMap, rules []typedRule[T], phase goskema.Phase) goskema.Issues {
 	var iss goskema.Issues
 	r := goskema.NewRef(pres)
-	dctx := goskema.DomainCtx[T]{Ctx: ctx, Presence: pres, Req: goskema.RequestInfo[T]{}, Ref: r}
+	req, _ := goskema.RequestFrom[T](ctx)
+	dctx := goskema.DomainCtx[T]{Ctx: ctx, Presence: pres, Req: req, Ref: r}
 	for _, tr := range rules {
 		ph := tr.opt.Phase
 		if ph == 0 {
@@ -95,7 +103,8 @@ func runTypedRules[T any](ctx context.Context, v T, pres goskema.PresenceMap, ru
 func runTypedRulesE[T any](ctx context.Context, v T, pres goskema.PresenceMap, rules []typedRuleE[T], phase goskema.Phase) (goskema.Issues, error) {
 	var iss goskema.Issues
 	r := goskema.NewRef(pres)
-	dctx := goskema.DomainCtx[T]{Ctx: ctx, Presence: pres, Req: goskema.RequestInfo[T]{}, Ref: r}
+	req, _ := goskema.RequestFrom[T](ctx)
+	dctx := goskema.DomainCtx[T]{Ctx: ctx, Presence: pres, Req: req, Ref: r}
 	for _, tr := range rules {
 		ph := tr.opt.Phase
 		if ph == 0 {
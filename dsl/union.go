@@ -81,12 +81,14 @@ func (u *unionSchema) ValidateValue(ctx context.Context, v map[string]any) error
 	return s.ValidateValue(ctx, v)
 }
 
-func (u *unionSchema) JSONSchema() (*js.Schema, error) {
+func (u *unionSchema) JSONSchema() (*js.Schema, error) { return u.exportJSONSchema(&jsonExport{}) }
+
+func (u *unionSchema) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
 	// oneOf with variant schemas; discriminator field documented implicitly
 	out := &js.Schema{}
 	out.OneOf = make([]*js.Schema, 0, len(u.mapping))
 	for _, s := range u.mapping {
-		vs, err := s.JSONSchema()
+		vs, err := exportJSONSchema(s, ex)
 		if err != nil {
 			return nil, err
 		}
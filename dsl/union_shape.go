@@ -0,0 +1,274 @@
+package dsl
+
+import (
+	"context"
+	"strconv"
+
+	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/i18n"
+	eng "github.com/reoring/goskema/internal/engine"
+	js "github.com/reoring/goskema/jsonschema"
+)
+
+// UnionStrategy selects how a non-discriminated Union resolves multiple matching branches.
+type UnionStrategy int
+
+const (
+	// UnionFirstMatch picks the first branch (in declaration order) that parses successfully.
+	UnionFirstMatch UnionStrategy = iota
+	// UnionOneOf requires exactly one branch to match; more than one yields union_ambiguous.
+	UnionOneOf
+	// UnionBestScore picks the matching branch that retains the most input keys (objects);
+	// ties and non-object values fall back to declaration order.
+	UnionBestScore
+)
+
+// UnionBuilder exposes chaining options for shape-based unions while implementing Schema[any].
+type UnionBuilder interface {
+	goskema.Schema[any]
+	// Strategy sets the resolution strategy (default UnionFirstMatch).
+	Strategy(s UnionStrategy) UnionBuilder
+}
+
+// Union returns a non-discriminated union over arbitrary branches (e.g. string | number | object).
+// When no branch matches, the Issues of the closest branch are reported.
+// Streaming buffers only the current value before trying the branches.
+func Union(branches ...AnyAdapter) UnionBuilder {
+	return &shapeUnionSchema{branches: append([]AnyAdapter(nil), branches...)}
+}
+
+// UnionOfSchema converts a UnionBuilder into an AnyAdapter for use in object builders.
+func UnionOfSchema(ub UnionBuilder) AnyAdapter { return anyAdapterFromSchema[any](ub) }
+
+type shapeUnionSchema struct {
+	branches []AnyAdapter
+	strategy UnionStrategy
+}
+
+// Strategy sets the resolution strategy.
+func (u *shapeUnionSchema) Strategy(s UnionStrategy) UnionBuilder { u.strategy = s; return u }
+
+// checkBuild delegates build-time checks to every branch.
+func (u *shapeUnionSchema) checkBuild() error {
+	for _, b := range u.branches {
+		if err := checkAdapterBuild(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// branchResult records the outcome of trying one branch.
+type branchResult struct {
+	index int
+	value any
+	iss   goskema.Issues
+}
+
+func (u *shapeUnionSchema) Parse(ctx context.Context, v any) (any, error) {
+	var matches []branchResult
+	var failures []branchResult
+	for i, b := range u.branches {
+		pv, err := b.parse(ctx, v)
+		if err != nil {
+			failures = append(failures, branchResult{index: i, iss: issuesFromErr("/", err)})
+			continue
+		}
+		matches = append(matches, branchResult{index: i, value: pv})
+		if u.strategy == UnionFirstMatch {
+			break
+		}
+	}
+	if len(matches) == 0 {
+		return nil, closestBranchIssues(failures)
+	}
+	switch u.strategy {
+	case UnionOneOf:
+		if len(matches) > 1 {
+			return nil, ambiguousIssues(matches)
+		}
+	case UnionBestScore:
+		best := matches[0]
+		bestScore := retainedKeys(v, best.value)
+		for _, m := range matches[1:] {
+			if sc := retainedKeys(v, m.value); sc > bestScore {
+				best, bestScore = m, sc
+			}
+		}
+		return best.value, nil
+	}
+	return matches[0].value, nil
+}
+
+// retainedKeys scores a match by the number of input object keys kept in the parsed output.
+func retainedKeys(in, out any) int {
+	im, ok := in.(map[string]any)
+	if !ok {
+		return 0
+	}
+	om, ok := out.(map[string]any)
+	if !ok {
+		// typed projections (structs) keep what they bind; treat them as retaining everything
+		return len(im)
+	}
+	n := 0
+	for k := range im {
+		if _, ok := om[k]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+func ambiguousIssues(matches []branchResult) goskema.Issues {
+	idx := make([]int, 0, len(matches))
+	hint := "matched branches:"
+	for _, m := range matches {
+		idx = append(idx, m.index)
+		hint += " " + strconv.Itoa(m.index)
+	}
+	return goskema.Issues{{Path: "/", Code: goskema.CodeUnionAmbiguous, Message: i18n.T(goskema.CodeUnionAmbiguous, nil), Hint: hint, Params: map[string]any{"matches": idx}}}
+}
+
+// closestBranchIssues picks the failing branch that got furthest: a branch rejected at the root
+// with invalid_type is the furthest away; otherwise fewer issues is closer, ties keep declaration order.
+func closestBranchIssues(failures []branchResult) goskema.Issues {
+	var best *branchResult
+	bestRootType := true
+	for i := range failures {
+		f := &failures[i]
+		rootType := isRootTypeMismatch(f.iss)
+		switch {
+		case best == nil:
+		case bestRootType && !rootType:
+		case rootType == bestRootType && len(f.iss) < len(best.iss):
+		default:
+			continue
+		}
+		best, bestRootType = f, rootType
+	}
+	if best == nil || bestRootType {
+		return goskema.Issues{{Path: "/", Code: goskema.CodeInvalidType, Message: i18n.T(goskema.CodeInvalidType, nil), Hint: "no union branch matched"}}
+	}
+	return best.iss
+}
+
+func isRootTypeMismatch(iss goskema.Issues) bool {
+	for _, it := range iss {
+		if (it.Path == "/" || it.Path == "") && it.Code == goskema.CodeInvalidType {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *shapeUnionSchema) ParseWithMeta(ctx context.Context, v any) (goskema.Decoded[any], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (any, error) { return u.Parse(ctx, v) })
+}
+
+// ---- streaming SPI ----
+// ParseFromSource buffers only the current value (as the any tree) and then resolves branches.
+// Depth, size and duplicate-key limits of opt apply while buffering.
+func (u *shapeUnionSchema) ParseFromSource(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (any, error) {
+	engSrc := goskema.EngineTokenSource(src)
+	var collected []eng.SimpleIssue
+	dup := eng.DupIgnore
+	switch opt.Strictness.OnDuplicateKey {
+	case goskema.Error:
+		dup = eng.DupError
+	case goskema.Warn:
+		dup = eng.DupWarn
+	}
+	enforced := eng.WrapWithEnforcement(engSrc, eng.EnforceOptions{
+		OnDuplicate: dup,
+		MaxDepth:    opt.MaxDepth,
+		MaxBytes:    opt.MaxBytes,
+		IssueSink: func(si eng.SimpleIssue) {
+			collected = append(collected, si)
+		},
+		FailFast: opt.FailFast,
+	})
+	var anyVal any
+	var err error
+	if src.NumberMode() == goskema.NumberFloat64 {
+		anyVal, err = eng.DecodeAnyFromSourceAsFloat64(enforced)
+	} else {
+		anyVal, err = eng.DecodeAnyFromSource(enforced)
+	}
+	if err != nil {
+		return nil, goskema.Issues{{Path: "/", Code: goskema.CodeParseError, Message: err.Error(), Cause: err}}
+	}
+	if len(collected) > 0 && !goskema.IsFailFast(ctx) {
+		var iss goskema.Issues
+		for _, si := range collected {
+			iss = goskema.AppendIssues(iss, goskema.Issue{Path: si.Path, Code: si.Code, Message: si.Message})
+		}
+		return nil, iss
+	}
+	return u.Parse(ctx, anyVal)
+}
+
+func (u *shapeUnionSchema) ParseFromSourceWithMeta(ctx context.Context, src goskema.Source, opt goskema.ParseOpt) (goskema.Decoded[any], error) {
+	return parseWithPresence(ctx, goskema.PresenceMap{"/": goskema.PresenceSeen}, func(ctx context.Context) (any, error) { return u.ParseFromSource(ctx, src, opt) })
+}
+
+func (u *shapeUnionSchema) TypeCheck(ctx context.Context, v any) error {
+	_, err := u.Parse(ctx, v)
+	return err
+}
+
+func (u *shapeUnionSchema) RuleCheck(ctx context.Context, v any) error { return nil }
+
+func (u *shapeUnionSchema) Validate(ctx context.Context, v any) error {
+	if err := u.TypeCheck(ctx, v); err != nil {
+		return err
+	}
+	return u.RuleCheck(ctx, v)
+}
+
+// ValidateValue checks a domain value against the branches using the same strategy rules.
+func (u *shapeUnionSchema) ValidateValue(ctx context.Context, v any) error {
+	var matches []branchResult
+	var failures []branchResult
+	for i, b := range u.branches {
+		if b.validateValue == nil {
+			continue
+		}
+		if err := b.validateValue(ctx, v); err != nil {
+			failures = append(failures, branchResult{index: i, iss: issuesFromErr("/", err)})
+			continue
+		}
+		matches = append(matches, branchResult{index: i, value: v})
+		if u.strategy != UnionOneOf {
+			break
+		}
+	}
+	if len(matches) == 0 {
+		return closestBranchIssues(failures)
+	}
+	if u.strategy == UnionOneOf && len(matches) > 1 {
+		return ambiguousIssues(matches)
+	}
+	return nil
+}
+
+// JSONSchema exports oneOf for UnionOneOf and anyOf otherwise.
+func (u *shapeUnionSchema) JSONSchema() (*js.Schema, error) { return u.exportJSONSchema(&jsonExport{}) }
+
+func (u *shapeUnionSchema) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	list := make([]*js.Schema, 0, len(u.branches))
+	for _, b := range u.branches {
+		bs := &js.Schema{}
+		if b.jsonSchema != nil {
+			var err error
+			if bs, err = b.jsonSchema(ex); err != nil {
+				return nil, err
+			}
+		}
+		list = append(list, bs)
+	}
+	if u.strategy == UnionOneOf {
+		return &js.Schema{OneOf: list}, nil
+	}
+	return &js.Schema{AnyOf: list}, nil
+}
@@ -0,0 +1,104 @@
+package dsl_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+	g "github.com/reoring/goskema/dsl"
+)
+
+func TestUnion_FirstMatch_Primitives(t *testing.T) {
+	ctx := context.Background()
+	u := g.Union(g.StringOf[string](), g.IntOf[int]())
+
+	if v, err := u.Parse(ctx, "x"); err != nil || v != "x" {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	if v, err := u.Parse(ctx, json.Number("3")); err != nil || v != 3 {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	_, err := u.Parse(ctx, true)
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Code != goskema.CodeInvalidType || iss[0].Hint != "no union branch matched" {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+func TestUnion_OneOf_Ambiguous(t *testing.T) {
+	ctx := context.Background()
+	a := g.Object().Field("id", g.StringOf[string]()).Required().UnknownStrip().MustBuild()
+	b := g.Object().Field("name", g.StringOf[string]()).UnknownStrip().MustBuild()
+	u := g.Union(g.SchemaOf[map[string]any](a), g.SchemaOf[map[string]any](b)).Strategy(g.UnionOneOf)
+
+	_, err := u.Parse(ctx, map[string]any{"id": "1"})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Code != goskema.CodeUnionAmbiguous || !reflect.DeepEqual(iss[0].Params["matches"], []int{0, 1}) {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+	if _, err := u.Parse(ctx, map[string]any{"name": "n"}); err != nil {
+		t.Fatalf("only branch b should match: %v", err)
+	}
+}
+
+func TestUnion_BestScore_And_ClosestIssues(t *testing.T) {
+	ctx := context.Background()
+	small := g.Object().Field("id", g.StringOf[string]()).Required().UnknownStrip().MustBuild()
+	large := g.Object().
+		Field("id", g.StringOf[string]()).Required().
+		Field("name", g.StringOf[string]()).Required().
+		UnknownStrip().
+		MustBuild()
+	u := g.Union(g.SchemaOf[map[string]any](small), g.SchemaOf[map[string]any](large)).Strategy(g.UnionBestScore)
+
+	v, err := u.Parse(ctx, map[string]any{"id": "1", "name": "n"})
+	if err != nil || !reflect.DeepEqual(v, map[string]any{"id": "1", "name": "n"}) {
+		t.Fatalf("best-score should pick the branch keeping both keys: %v %v", v, err)
+	}
+
+	// No branch matches: report the object branch's issues rather than the string branch's type mismatch.
+	strict := g.Union(g.StringOf[string](), g.SchemaOf[map[string]any](large))
+	_, err = strict.Parse(ctx, map[string]any{"id": "1"})
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/name" || iss[0].Code != goskema.CodeRequired {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+}
+
+func TestUnion_Streaming_And_JSONSchema(t *testing.T) {
+	ctx := context.Background()
+	obj := g.Object().
+		Field("value", g.UnionOfSchema(g.Union(g.StringOf[string](), g.BoolOf[bool]()))).Required().
+		UnknownStrict().
+		MustBuild()
+	v, err := goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"value":true}`)))
+	if err != nil || v["value"] != true {
+		t.Fatalf("unexpected: %v %v", v, err)
+	}
+	_, err = goskema.ParseFrom(ctx, obj, goskema.JSONBytes([]byte(`{"value":[1]}`)))
+	iss, _ := goskema.AsIssues(err)
+	if len(iss) != 1 || iss[0].Path != "/value" || iss[0].Code != goskema.CodeInvalidType {
+		t.Fatalf("unexpected issues: %+v", iss)
+	}
+
+	s, _ := g.Union(g.StringOf[string](), g.BoolOf[bool]()).Strategy(g.UnionOneOf).JSONSchema()
+	want := normalize(map[string]any{"oneOf": []any{map[string]any{"type": "string"}, map[string]any{"type": "boolean"}}})
+	if got := normalize(s); !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema mismatch\n got=%v\nwant=%v", got, want)
+	}
+}
+
+func TestUnion_Streaming_HonorsMaxDepth(t *testing.T) {
+	ctx := context.Background()
+	u := g.Union(g.StringOf[string](), g.SchemaOf(g.MapAny()))
+	deep := strings.Repeat(`{"a":`, 10) + `1` + strings.Repeat(`}`, 10)
+	if _, err := goskema.ParseFrom(ctx, u, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 5}); err == nil {
+		t.Fatalf("expected max depth error")
+	}
+	if _, err := goskema.ParseFrom(ctx, u, goskema.JSONBytes([]byte(deep)), goskema.ParseOpt{MaxDepth: 20}); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+}
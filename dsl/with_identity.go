@@ -52,6 +52,9 @@ func (w identitySchemaView[T]) ValidateValue(ctx context.Context, v T) error {
 }
 
 func (w identitySchemaView[T]) JSONSchema() (*js.Schema, error) { return w.inner.JSONSchema() }
+func (w identitySchemaView[T]) exportJSONSchema(ex *jsonExport) (*js.Schema, error) {
+	return exportJSONSchema(w.inner, ex)
+}
 
 // ---- identity codec sugar ----
 
@@ -0,0 +1,58 @@
+package goskema
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// WireEncoder is implemented by schemas that can encode a domain value back into its wire
+// shape (map[string]any, []any and JSON scalars): object fields under their schema names, field
+// codecs applied in reverse (e.g. time.Time -> RFC3339 string). Schemas that do not implement it
+// are treated as producing wire values already.
+type WireEncoder[T any] interface {
+	EncodeWire(ctx context.Context, v T) (any, error)
+}
+
+// EncodeToMap encodes v through an object schema (e.g. a typed object from MustBind) into its
+// wire map. v is checked with s.ValidateValue first. Missing fields with a static default are
+// emitted with it (canonical output); write-only fields are omitted.
+func EncodeToMap[T any](ctx context.Context, s Schema[T], v T) (map[string]any, error) {
+	w, err := encodeWire(ctx, s, v)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := w.(map[string]any)
+	if !ok {
+		return nil, singleIssue(CodeInvalidType, "schema does not encode to an object")
+	}
+	return m, nil
+}
+
+// EncodeTo encodes v through s (see EncodeToMap) and writes it to w as JSON. Object keys are
+// written in the schema's key order (sorted), so the output is deterministic.
+func EncodeTo[T any](ctx context.Context, s Schema[T], v T, w io.Writer) error {
+	wire, err := encodeWire(ctx, s, v)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(wire)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func encodeWire[T any](ctx context.Context, s Schema[T], v T) (any, error) {
+	if s == nil {
+		return nil, singleIssue(CodeParseError, "nil schema")
+	}
+	if err := s.ValidateValue(ctx, v); err != nil {
+		return nil, err
+	}
+	if we, ok := s.(WireEncoder[T]); ok {
+		return we.EncodeWire(ctx, v)
+	}
+	return v, nil
+}
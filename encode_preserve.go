@@ -1,32 +1,174 @@
 package goskema
 
-// EncodePreservingObject returns a shallow-copied map that respects presence
-// semantics for preserving output:
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+// EncodePreservingObject returns a copy of the object that respects presence
+// semantics for preserving output, at every depth of nested objects, arrays and maps:
 //   - Fields materialized only by defaults (PresenceDefaultApplied set while not seen)
-//     are removed to keep them missing in the output.
+//     are removed to keep them missing in the output, e.g. "/spec/replicas".
+//   - Write-only fields (PresenceWriteOnly, e.g. passwords) are removed.
 //   - Fields explicitly present as null (PresenceWasNull) are kept as-is.
-//   - Other fields are copied verbatim.
+//   - Other values are copied verbatim.
 //
-// This function operates only on the top-level object keys.
+// Containers on the way (map[string]any, []any and other slices/maps of them) are copied;
+// scalar leaves are shared with db.Value.
 func EncodePreservingObject(db Decoded[map[string]any]) map[string]any {
-	in := db.Value
-	if in == nil {
+	if db.Value == nil {
+		return nil
+	}
+	return preserveValue(db.Value, "", db.Presence, false).(map[string]any)
+}
+
+// EncodePreservingArray applies the rules of EncodePreservingObject to the elements of the
+// array (paths "/0/..."). Elements themselves are never removed.
+func EncodePreservingArray(db Decoded[[]any]) []any {
+	if db.Value == nil {
 		return nil
 	}
-	out := make(map[string]any, len(in))
-	for k, v := range in {
-		out[k] = v
+	return preserveValue(db.Value, "", db.Presence, false).([]any)
+}
+
+// EncodePreservingToMap encodes db.Value through s like EncodeToMap, with preserving
+// semantics driven by db.Presence (see EncodePreservingObject): static defaults are not
+// materialized, and fields that were defaulted or are write-only are removed at every depth.
+// For struct values (e.g. from MustBind) a field missing from the input still exists in the
+// struct, so a field that was neither seen nor null is also removed while it holds its zero value.
+//
+//	dm, _ := goskema.ParseFromWithMeta(ctx, deploy, goskema.JSONBytes(body))
+//	out, _ := goskema.EncodePreservingToMap(ctx, deploy, dm) // no "replicas" unless given
+func EncodePreservingToMap[T any](ctx context.Context, s Schema[T], db Decoded[T]) (map[string]any, error) {
+	w, err := encodePreserving(ctx, s, db)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := w.(map[string]any)
+	if !ok {
+		return nil, singleIssue(CodeInvalidType, "schema does not encode to an object")
+	}
+	return m, nil
+}
+
+// EncodePreservingTo encodes db through s (see EncodePreservingToMap) and writes it to w as JSON.
+func EncodePreservingTo[T any](ctx context.Context, s Schema[T], db Decoded[T], w io.Writer) error {
+	wire, err := encodePreserving(ctx, s, db)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(wire)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func encodePreserving[T any](ctx context.Context, s Schema[T], db Decoded[T]) (any, error) {
+	wire, err := encodeWire(WithEncodeMode(ctx, EncodePreserve), s, db.Value)
+	if err != nil {
+		return nil, err
+	}
+	_, wireShaped := any(db.Value).(map[string]any)
+	return preserveValue(wire, "", db.Presence, !wireShaped), nil
+}
+
+// preserveValue copies v (located at base) without the entries preserving output leaves out.
+// zeroUnseen additionally removes object/map entries that were neither seen nor null and hold
+// an empty value (see EncodePreservingToMap).
+func preserveValue(v any, base string, pm PresenceMap, zeroUnseen bool) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, val := range t {
+			p := base + "/" + k
+			if cv, keep := preserveEntry(val, p, pm, zeroUnseen); keep {
+				out[k] = cv
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, val := range t {
+			out[i] = preserveValue(val, base+"/"+itoa(i), pm, zeroUnseen)
+		}
+		return out
 	}
-	for k := range in {
-		p := db.Presence["/"+k]
-		isDefaultOnly := p&PresenceDefaultApplied != 0 && p&PresenceSeen == 0 && p&PresenceWasNull == 0
-		if isDefaultOnly {
-			delete(out, k)
+	// typed containers of objects, e.g. []map[string]any or map[string]map[string]any
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() || !holdsContainers(rv.Type().Elem()) {
+			return v
+		}
+		out := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			setValue(out.Index(i), preserveValue(rv.Index(i).Interface(), base+"/"+itoa(i), pm, zeroUnseen))
+		}
+		return out.Interface()
+	case reflect.Map:
+		if rv.IsNil() || rv.Type().Key().Kind() != reflect.String || !holdsContainers(rv.Type().Elem()) {
+			return v
 		}
+		out := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		it := rv.MapRange()
+		for it.Next() {
+			cv, keep := preserveEntry(it.Value().Interface(), base+"/"+it.Key().String(), pm, zeroUnseen)
+			if !keep {
+				continue
+			}
+			ev := reflect.New(rv.Type().Elem()).Elem()
+			setValue(ev, cv)
+			out.SetMapIndex(it.Key(), ev)
+		}
+		return out.Interface()
+	}
+	return v
+}
+
+// preserveEntry prunes the object entry at path p and reports whether it is kept.
+func preserveEntry(v any, p string, pm PresenceMap, zeroUnseen bool) (any, bool) {
+	pr := pm[p]
+	given := pr&(PresenceSeen|PresenceWasNull) != 0
+	if pr&PresenceWriteOnly != 0 || (pr&PresenceDefaultApplied != 0 && !given) {
+		return nil, false
 	}
-	return out
+	cv := preserveValue(v, p, pm, zeroUnseen)
+	if zeroUnseen && !given && isEmptyValue(cv) {
+		return nil, false
+	}
+	return cv, true
 }
 
-// EncodePreservingArray returns the slice as-is. Array elements currently do not
-// carry default materialization semantics at element granularity in MVP.
-func EncodePreservingArray(db Decoded[[]any]) []any { return db.Value }
+// holdsContainers reports whether values of t may contain objects or arrays to prune.
+func holdsContainers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Map, reflect.Slice:
+		return holdsContainers(t.Elem())
+	}
+	return false
+}
+
+// setValue stores x into dst, leaving dst zero for nil.
+func setValue(dst reflect.Value, x any) {
+	if x != nil {
+		dst.Set(reflect.ValueOf(x))
+	}
+}
+
+// isEmptyValue reports nil, zero values and empty maps/slices.
+func isEmptyValue(v any) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Map, reflect.Slice:
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
@@ -12,8 +12,10 @@ const (
 	CodeRequired             = "required"
 	CodeUnknownKey           = "unknown_key"
 	CodeDuplicateKey         = "duplicate_key"
+	CodeDuplicateItem        = "duplicate_item"
 	CodeTooSmall             = "too_small"
 	CodeTooBig               = "too_big"
+	CodeNotMultipleOf        = "not_multiple_of"
 	CodeTooShort             = "too_short"
 	CodeTooLong              = "too_long"
 	CodePattern              = "pattern"
@@ -25,6 +27,7 @@ const (
 	CodeParseError           = "parse_error"
 	CodeOverflow             = "overflow"
 	CodeTruncated            = "truncated"
+	CodeDeprecatedField      = "deprecated_field" // warning: a deprecated alias was used
 	// Domain/Context passes (business semantics)
 	CodeDomainRange        = "domain_range"
 	CodeAggregateViolation = "aggregate_violation"
@@ -51,8 +54,14 @@ type Issue struct {
 	Params map[string]any
 	// Rule optionally records the rule name that produced this issue.
 	Rule string
+	// Severity is Warn for advisory issues reported via ReportWarning (e.g. deprecated_field);
+	// validation errors leave it zero.
+	Severity Severity
 }
 
+// IsWarning reports whether the issue is advisory (Severity Warn) rather than a validation error.
+func (it Issue) IsWarning() bool { return it.Severity == Warn }
+
 // Issues is a collection of validation errors that implements error.
 type Issues []Issue
 
@@ -164,6 +164,22 @@ func (dc DomainCtx[T]) DefaultApplied(field FieldToken[T]) bool {
 	return dc.Presence["/"+field.key]&PresenceDefaultApplied != 0
 }
 
+// Transformed reports whether the given field value was changed by a Preprocess/Transform stage.
+func (dc DomainCtx[T]) Transformed(field FieldToken[T]) bool {
+	if dc.Presence == nil {
+		return false
+	}
+	return dc.Presence["/"+field.key]&PresenceTransformed != 0
+}
+
+// Coerced reports whether the given field was coerced from a string input (Coerce).
+func (dc DomainCtx[T]) Coerced(field FieldToken[T]) bool {
+	if dc.Presence == nil {
+		return false
+	}
+	return dc.Presence["/"+field.key]&PresenceCoerced != 0
+}
+
 // AnySeen reports whether any of the given fields were present.
 func (dc DomainCtx[T]) AnySeen(fields ...FieldToken[T]) bool {
 	for _, f := range fields {
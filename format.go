@@ -0,0 +1,177 @@
+package goskema
+
+import (
+	"errors"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FormatFunc validates a string against a named format and returns a non-nil error on mismatch.
+type FormatFunc func(string) error
+
+// FormatRegistry maps format names (e.g. "email") to validators.
+// A registry created by NewFormatRegistry falls back to the global registry for names it does not define,
+// so per-schema registries only need to add or override the formats they care about.
+type FormatRegistry struct {
+	mu     sync.RWMutex
+	m      map[string]FormatFunc
+	parent *FormatRegistry
+}
+
+// NewFormatRegistry returns an empty registry layered on top of the global one.
+func NewFormatRegistry() *FormatRegistry {
+	return &FormatRegistry{m: map[string]FormatFunc{}, parent: globalFormats}
+}
+
+// Register adds or replaces a format validator. It returns the registry for chaining.
+func (r *FormatRegistry) Register(name string, fn FormatFunc) *FormatRegistry {
+	r.mu.Lock()
+	r.m[name] = fn
+	r.mu.Unlock()
+	return r
+}
+
+// Lookup resolves a format by name, consulting the parent registry when not found locally.
+func (r *FormatRegistry) Lookup(name string) (FormatFunc, bool) {
+	for cur := r; cur != nil; cur = cur.parent {
+		cur.mu.RLock()
+		fn, ok := cur.m[name]
+		cur.mu.RUnlock()
+		if ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+// globalFormats is the process-wide registry, pre-populated with the built-in formats.
+var globalFormats = &FormatRegistry{m: map[string]FormatFunc{
+	"email":     checkEmail,
+	"uuid":      checkUUID,
+	"uri":       checkURI,
+	"hostname":  checkHostname,
+	"ip":        checkIP,
+	"ipv4":      checkIPv4,
+	"ipv6":      checkIPv6,
+	"cidr":      checkCIDR,
+	"date":      checkDate,
+	"date-time": checkDateTime,
+	"duration":  checkISODuration,
+}}
+
+// RegisterFormat registers a format validator in the global registry.
+// Register custom formats during init so that they are available before schemas are built.
+func RegisterFormat(name string, fn func(string) error) {
+	globalFormats.Register(name, fn)
+}
+
+// LookupFormat resolves a format from the global registry.
+func LookupFormat(name string) (FormatFunc, bool) { return globalFormats.Lookup(name) }
+
+// ---- built-in formats ----
+
+func checkEmail(s string) error {
+	a, err := mail.ParseAddress(s)
+	if err != nil {
+		return err
+	}
+	// Reject display-name forms such as "Alice <a@example.com>".
+	if a.Address != s {
+		return errors.New("email must be a bare addr-spec")
+	}
+	return nil
+}
+
+var uuidRe = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func checkUUID(s string) error {
+	if !uuidRe.MatchString(s) {
+		return errors.New("uuid must be 8-4-4-4-12 hex digits")
+	}
+	return nil
+}
+
+func checkURI(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" {
+		return errors.New("uri must be absolute (scheme required)")
+	}
+	return nil
+}
+
+var hostnameLabelRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// checkHostname follows RFC 1123: dot-separated labels of 1..63 chars, 253 chars total.
+func checkHostname(s string) error {
+	if s == "" || len(s) > 253 {
+		return errors.New("hostname must be 1..253 characters")
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(s, "."), ".") {
+		if !hostnameLabelRe.MatchString(label) {
+			return errors.New("invalid hostname label: " + label)
+		}
+	}
+	return nil
+}
+
+func checkIP(s string) error {
+	if _, err := netip.ParseAddr(s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func checkIPv4(s string) error {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+	if !a.Is4() {
+		return errors.New("not an IPv4 address")
+	}
+	return nil
+}
+
+func checkIPv6(s string) error {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+	if !a.Is6() {
+		return errors.New("not an IPv6 address")
+	}
+	return nil
+}
+
+func checkCIDR(s string) error {
+	_, err := netip.ParsePrefix(s)
+	return err
+}
+
+func checkDate(s string) error {
+	_, err := time.Parse(time.DateOnly, s)
+	return err
+}
+
+func checkDateTime(s string) error {
+	_, err := time.Parse(time.RFC3339Nano, s)
+	return err
+}
+
+// isoDurationRe accepts ISO 8601 durations as used by JSON Schema (e.g. P1Y2M3DT4H5M6S, PT0.5S, P2W).
+var isoDurationRe = regexp.MustCompile(`^P(?:(\d+W)|(?:\d+Y)?(?:\d+M)?(?:\d+D)?(?:T(?:\d+H)?(?:\d+M)?(?:\d+(?:[.,]\d+)?S)?)?)$`)
+
+func checkISODuration(s string) error {
+	if !isoDurationRe.MatchString(s) || s == "P" || strings.HasSuffix(s, "T") {
+		return errors.New("duration must be ISO 8601 (e.g. P1DT2H)")
+	}
+	return nil
+}
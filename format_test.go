@@ -0,0 +1,59 @@
+package goskema_test
+
+import (
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+)
+
+func TestFormat_Builtins(t *testing.T) {
+	cases := []struct {
+		format string
+		ok     []string
+		ng     []string
+	}{
+		{"email", []string{"a@example.com"}, []string{"Alice <a@example.com>", "nope"}},
+		{"uuid", []string{"123e4567-e89b-12d3-a456-426614174000"}, []string{"123e4567e89b12d3a456426614174000"}},
+		{"uri", []string{"https://example.com/x?y=1"}, []string{"/relative/path"}},
+		{"hostname", []string{"api.example.com", "localhost"}, []string{"-bad.example.com", "a..b"}},
+		{"ip", []string{"10.0.0.1", "::1"}, []string{"10.0.0.256"}},
+		{"cidr", []string{"10.0.0.0/8", "2001:db8::/32"}, []string{"10.0.0.1", "10.0.0.0/33"}},
+		{"date", []string{"2024-02-29"}, []string{"2023-02-29", "2024-1-1"}},
+		{"date-time", []string{"2024-01-02T03:04:05Z", "2024-01-02T03:04:05.123+09:00"}, []string{"2024-01-02 03:04:05"}},
+		{"duration", []string{"P1D", "PT1H30M", "P1Y2M3DT4H5M6.5S", "P2W"}, []string{"P", "PT", "1D", "P1H"}},
+	}
+	for _, c := range cases {
+		fn, ok := goskema.LookupFormat(c.format)
+		if !ok {
+			t.Fatalf("built-in format %q missing", c.format)
+		}
+		for _, v := range c.ok {
+			if err := fn(v); err != nil {
+				t.Errorf("%s: %q should be valid: %v", c.format, v, err)
+			}
+		}
+		for _, v := range c.ng {
+			if err := fn(v); err == nil {
+				t.Errorf("%s: %q should be invalid", c.format, v)
+			}
+		}
+	}
+}
+
+func TestFormatRegistry_FallsBackToGlobal(t *testing.T) {
+	r := goskema.NewFormatRegistry().Register("even-len", func(s string) error {
+		if len(s)%2 != 0 {
+			return goskema.Issues{{Code: goskema.CodeInvalidFormat}}
+		}
+		return nil
+	})
+	if _, ok := r.Lookup("even-len"); !ok {
+		t.Fatalf("local format not found")
+	}
+	if _, ok := r.Lookup("email"); !ok {
+		t.Fatalf("global format should be visible through a local registry")
+	}
+	if _, ok := goskema.LookupFormat("even-len"); ok {
+		t.Fatalf("local format leaked into the global registry")
+	}
+}
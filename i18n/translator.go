@@ -22,10 +22,30 @@ func (t dictTranslator) Message(code string, data map[string]string) string {
 			return "未知のキーです"
 		case "duplicate_key":
 			return "キーが重複しています"
+		case "duplicate_item":
+			return "要素が重複しています"
+		case "deprecated_field":
+			return "非推奨のフィールド名です"
 		case "too_short":
 			return "短すぎます"
 		case "too_long":
 			return "長すぎます"
+		case "too_small":
+			return "小さすぎます"
+		case "too_big":
+			return "大きすぎます"
+		case "not_multiple_of":
+			return "倍数ではありません"
+		case "pattern":
+			return "パターンに一致しません"
+		case "invalid_enum":
+			return "許可されていない値です"
+		case "invalid_format":
+			return "形式が不正です"
+		case "union_ambiguous":
+			return "複数の候補に一致しました"
+		case "conflict":
+			return "定義が競合しています"
 		case "parse_error":
 			return "解析エラー"
 		case "truncated":
@@ -43,10 +63,30 @@ func (t dictTranslator) Message(code string, data map[string]string) string {
 			return "unknown key"
 		case "duplicate_key":
 			return "duplicate key"
+		case "duplicate_item":
+			return "duplicate item"
+		case "deprecated_field":
+			return "deprecated field name"
 		case "too_short":
 			return "too short"
 		case "too_long":
 			return "too long"
+		case "too_small":
+			return "too small"
+		case "too_big":
+			return "too big"
+		case "not_multiple_of":
+			return "not a multiple of the required step"
+		case "pattern":
+			return "does not match pattern"
+		case "invalid_enum":
+			return "value not allowed"
+		case "invalid_format":
+			return "invalid format"
+		case "union_ambiguous":
+			return "value matches more than one union branch"
+		case "conflict":
+			return "conflicting definitions"
 		case "parse_error":
 			return "parse error"
 		case "truncated":
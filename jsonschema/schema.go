@@ -1,5 +1,11 @@
 package jsonschema
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
 // Schema is a minimal JSON Schema representation used for export.
 // Keep this struct small for MVP and extend incrementally.
 type Schema struct {
@@ -7,17 +13,207 @@ type Schema struct {
 	Type    string `json:"type,omitempty"`
 	Format  string `json:"format,omitempty"`
 	Default any    `json:"default,omitempty"`
+	Enum    []any  `json:"enum,omitempty"`
+	Const   any    `json:"const,omitempty"`
+
+	// Annotations
+	Deprecated bool `json:"deprecated,omitempty"`
+	ReadOnly   bool `json:"readOnly,omitempty"`
+	WriteOnly  bool `json:"writeOnly,omitempty"`
+
+	// References: Ref points at a definition ("#/$defs/<name>"); Defs holds definitions.
+	// Defs declared by nested subschemas are moved to the document root on marshal.
+	Ref  string             `json:"$ref,omitempty"`
+	Defs map[string]*Schema `json:"$defs,omitempty"`
+
+	// String
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	// ContentEncoding names the encoding of binary data carried in a string (e.g. "base64").
+	ContentEncoding string `json:"contentEncoding,omitempty"`
+
+	// Number
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       *float64 `json:"multipleOf,omitempty"`
 
 	// Object
-	Properties           map[string]*Schema `json:"properties,omitempty"`
-	Required             []string           `json:"required,omitempty"`
-	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	Properties           map[string]*Schema  `json:"properties,omitempty"`
+	Required             []string            `json:"required,omitempty"`
+	AdditionalProperties any                 `json:"additionalProperties,omitempty"`
+	MinProperties        *int                `json:"minProperties,omitempty"`
+	MaxProperties        *int                `json:"maxProperties,omitempty"`
+	PropertyNames        *Schema             `json:"propertyNames,omitempty"`
+	DependentRequired    map[string][]string `json:"dependentRequired,omitempty"`
 
 	// Array
-	Items    *Schema `json:"items,omitempty"`
-	MinItems *int    `json:"minItems,omitempty"`
-	MaxItems *int    `json:"maxItems,omitempty"`
+	PrefixItems []*Schema `json:"prefixItems,omitempty"`
+	Items       *Schema   `json:"items,omitempty"`
+	MinItems    *int      `json:"minItems,omitempty"`
+	MaxItems    *int      `json:"maxItems,omitempty"`
+	UniqueItems bool      `json:"uniqueItems,omitempty"`
+	Contains    *Schema   `json:"contains,omitempty"`
+	MinContains *int      `json:"minContains,omitempty"`
+	MaxContains *int      `json:"maxContains,omitempty"`
 
-	// Union
+	// Composition
 	OneOf []*Schema `json:"oneOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	AllOf []*Schema `json:"allOf,omitempty"`
+	Not   *Schema   `json:"not,omitempty"`
+
+	// Conditional
+	If   *Schema `json:"if,omitempty"`
+	Then *Schema `json:"then,omitempty"`
+	Else *Schema `json:"else,omitempty"`
+
+	// Boolean, when set, makes the schema marshal as the boolean schema true/false
+	// (e.g. `items: false` to forbid additional tuple elements). Other fields are ignored.
+	Boolean *bool `json:"-"`
+}
+
+// False returns the boolean schema `false`, which matches nothing.
+func False() *Schema {
+	b := false
+	return &Schema{Boolean: &b}
+}
+
+// MarshalJSON emits boolean schemas as plain true/false and hoists nested $defs to the root,
+// so that "#/$defs/<name>" references resolve against the marshaled document. Different
+// definitions declared under the same name are an error.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.Boolean != nil {
+		return json.Marshal(*s.Boolean)
+	}
+	type plain Schema
+	if len(s.Defs) > 0 || hasNestedDefs(s) {
+		h := &hoister{defs: map[string]*Schema{}}
+		root := h.withoutDefs(s)
+		if h.err != nil {
+			return nil, h.err
+		}
+		root.Defs = h.defs
+		return json.Marshal((*plain)(root))
+	}
+	return json.Marshal((*plain)(s))
+}
+
+// hasNestedDefs reports whether a subschema below s declares $defs.
+func hasNestedDefs(s *Schema) bool {
+	found := false
+	walk(s, func(c *Schema) {
+		if len(c.Defs) > 0 {
+			found = true
+		}
+	})
+	return found
+}
+
+// walk calls fn for every subschema below s (not s itself, and not inside $defs).
+func walk(s *Schema, fn func(*Schema)) {
+	visit := func(c *Schema) {
+		if c != nil {
+			fn(c)
+			walk(c, fn)
+		}
+	}
+	for _, c := range s.Properties {
+		visit(c)
+	}
+	if c, ok := s.AdditionalProperties.(*Schema); ok {
+		visit(c)
+	}
+	visit(s.PropertyNames)
+	for _, c := range s.PrefixItems {
+		visit(c)
+	}
+	visit(s.Items)
+	visit(s.Contains)
+	for _, c := range []*Schema{s.Not, s.If, s.Then, s.Else} {
+		visit(c)
+	}
+	for _, list := range [][]*Schema{s.OneOf, s.AnyOf, s.AllOf} {
+		for _, c := range list {
+			visit(c)
+		}
+	}
+}
+
+// hoister collects the $defs of nested subschemas for the document root. Two different
+// definitions under one name are a conflict, since "#/$defs/<name>" can only resolve to one.
+type hoister struct {
+	defs map[string]*Schema
+	err  error
+}
+
+// withoutDefs returns a copy of s whose subschemas carry no $defs; nested definitions are
+// collected into h.defs (repeated identical declarations of a name are kept once).
+func (h *hoister) withoutDefs(s *Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+	out := *s
+	out.Defs = nil
+	for _, k := range sortedKeys(s.Defs) {
+		d := h.withoutDefs(s.Defs[k])
+		prev, ok := h.defs[k]
+		if !ok {
+			h.defs[k] = d
+			continue
+		}
+		if h.err == nil && !sameSchema(prev, d) {
+			h.err = fmt.Errorf("jsonschema: conflicting definitions for $defs/%s", k)
+		}
+	}
+	if s.Properties != nil {
+		out.Properties = make(map[string]*Schema, len(s.Properties))
+		for k, c := range s.Properties {
+			out.Properties[k] = h.withoutDefs(c)
+		}
+	}
+	if c, ok := s.AdditionalProperties.(*Schema); ok {
+		out.AdditionalProperties = h.withoutDefs(c)
+	}
+	out.PropertyNames = h.withoutDefs(s.PropertyNames)
+	out.PrefixItems = h.withoutDefsList(s.PrefixItems)
+	out.Items = h.withoutDefs(s.Items)
+	out.Contains = h.withoutDefs(s.Contains)
+	out.OneOf = h.withoutDefsList(s.OneOf)
+	out.AnyOf = h.withoutDefsList(s.AnyOf)
+	out.AllOf = h.withoutDefsList(s.AllOf)
+	out.Not = h.withoutDefs(s.Not)
+	out.If = h.withoutDefs(s.If)
+	out.Then = h.withoutDefs(s.Then)
+	out.Else = h.withoutDefs(s.Else)
+	return &out
+}
+
+func (h *hoister) withoutDefsList(list []*Schema) []*Schema {
+	if list == nil {
+		return nil
+	}
+	out := make([]*Schema, len(list))
+	for i, c := range list {
+		out[i] = h.withoutDefs(c)
+	}
+	return out
+}
+
+// sameSchema compares two definitions without $defs by their JSON form.
+func sameSchema(a, b *Schema) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
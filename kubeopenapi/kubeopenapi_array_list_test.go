@@ -137,6 +137,33 @@ func TestImport_ListType_Set_Duplicate_Detected(t *testing.T) {
 	}
 }
 
+func TestImport_ListType_Set_NonString_DuplicateIndex(t *testing.T) {
+	ctx := context.Background()
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"ports": map[string]any{
+				"type":                   "array",
+				"items":                  map[string]any{"type": "integer"},
+				"x-kubernetes-list-type": "set",
+			},
+		},
+		"additionalProperties": false,
+	}
+	s, _, err := kubeopenapi.Import(schema, kubeopenapi.Options{})
+	if err != nil {
+		t.Fatalf("import err: %v", err)
+	}
+	_, err = goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"ports":[80,443,80]}`)))
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 {
+		t.Fatalf("expected one duplicate issue, got %v", err)
+	}
+	if iss[0].Path != "/ports/2" || iss[0].Code != goskema.CodeDuplicateItem || iss[0].Params["firstIndex"] != 0 {
+		t.Fatalf("unexpected issue: %+v", iss[0])
+	}
+}
+
 func TestImport_ListType_Map_Duplicate_ByKeys(t *testing.T) {
 	ctx := context.Background()
 	schema := map[string]any{
@@ -234,3 +234,65 @@ func TestImport_PropertyNames_WithAdditionalPropertiesTrue_RejectsUnmatchedKeys(
 		t.Fatalf("expected propertyNames pattern violation for 'other'")
 	}
 }
+
+func TestImport_AdditionalProperties_PropertyCountsAndKeyPaths(t *testing.T) {
+	ctx := context.Background()
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"labels": map[string]any{
+				"type":                 "object",
+				"propertyNames":        map[string]any{"pattern": "^[a-z]+$"},
+				"additionalProperties": map[string]any{"type": "string"},
+				"minProperties":        1,
+				"maxProperties":        2,
+			},
+		},
+		"required":             []any{"labels"},
+		"additionalProperties": false,
+	}
+	s, _, err := kubeopenapi.Import(schema, kubeopenapi.Options{})
+	if err != nil {
+		t.Fatalf("import err: %v", err)
+	}
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"labels":{"a":"x"}}`))); err != nil {
+		t.Fatalf("expected accept: %v", err)
+	}
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"labels":{}}`))); err == nil {
+		t.Fatalf("expected minProperties violation")
+	}
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"labels":{"a":"x","b":"y","c":"z"}}`))); err == nil {
+		t.Fatalf("expected maxProperties violation")
+	}
+	_, err = goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"labels":{"Bad":"x"}}`)))
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Path != "/labels/Bad" || iss[0].Code != goskema.CodePattern {
+		t.Fatalf("expected key pattern issue at /labels/Bad, got %v", err)
+	}
+}
+
+func TestImport_PropertyNames_WithScalarPatternProperties(t *testing.T) {
+	ctx := context.Background()
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"labels": map[string]any{
+				"type":              "object",
+				"propertyNames":     map[string]any{"pattern": "^[a-z]+$"},
+				"patternProperties": map[string]any{"^.*$": map[string]any{"type": "string"}},
+			},
+		},
+	}
+	s, _, err := kubeopenapi.Import(schema, kubeopenapi.Options{})
+	if err != nil {
+		t.Fatalf("import err: %v", err)
+	}
+	if _, err := goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"labels":{"ok":"x"}}`))); err != nil {
+		t.Fatalf("expected accept: %v", err)
+	}
+	_, err = goskema.ParseFrom(ctx, s, goskema.JSONBytes([]byte(`{"labels":{"BAD":"x"}}`)))
+	iss, ok := goskema.AsIssues(err)
+	if !ok || len(iss) != 1 || iss[0].Path != "/labels/BAD" || iss[0].Code != goskema.CodePattern {
+		t.Fatalf("expected key pattern issue at /labels/BAD, got %v", err)
+	}
+}
@@ -3,9 +3,11 @@ package kubeopenapi
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/dsl"
 )
 
 // listUniquenessChecker represents uniqueness checks for x-kubernetes-list-type.
@@ -13,27 +15,36 @@ type listUniquenessChecker interface {
 	Check(fieldName string, val any) goskema.Issues
 }
 
-// setChecker performs uniqueness checks for set-type lists.
+// setChecker performs uniqueness checks for set-type lists on top of dsl.UniqueIssues
+// (scalars compare by value, objects/arrays by canonical JSON).
 type setChecker struct{}
 
 func (setChecker) Check(fieldName string, val any) goskema.Issues {
-	arr, ok := val.([]string)
-	if !ok {
-		return nil // MVP: only []string fully supported
+	var dups goskema.Issues
+	switch arr := val.(type) {
+	case []string:
+		dups = dsl.UniqueIssues(arr)
+	case []any:
+		dups = dsl.UniqueIssues(arr)
+	default:
+		// typed item projections ([]json.Number, []bool, []map[string]any, ...)
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Slice {
+			return nil
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+		dups = dsl.UniqueIssues(items)
 	}
-	seen := make(map[string]int, len(arr))
 	var iss goskema.Issues
-	for i, sv := range arr {
-		if j, dup := seen[sv]; dup {
-			iss = goskema.AppendIssues(iss, goskema.Issue{
-				Path:    "/" + fieldName + "/" + strconv.Itoa(i),
-				Code:    "duplicate_item",
-				Message: "duplicate element in set",
-				Hint:    "first at /" + fieldName + "/" + strconv.Itoa(j),
-			})
-			continue
-		}
-		seen[sv] = i
+	for _, it := range dups {
+		j, _ := it.Params["firstIndex"].(int)
+		it.Path = "/" + fieldName + it.Path
+		it.Message = "duplicate element in set"
+		it.Hint = "first at /" + fieldName + "/" + strconv.Itoa(j)
+		iss = goskema.AppendIssues(iss, it)
 	}
 	return iss
 }
@@ -65,9 +76,10 @@ func (c mapChecker) Check(fieldName string, val any) goskema.Issues {
 			if j, dup := seen[comp]; dup {
 				iss = goskema.AppendIssues(iss, goskema.Issue{
 					Path:    "/" + fieldName + "/" + strconv.Itoa(i),
-					Code:    "duplicate_item",
+					Code:    goskema.CodeDuplicateItem,
 					Message: "duplicate element in list-map by keys",
 					Hint:    "first at /" + fieldName + "/" + strconv.Itoa(j),
+					Params:  map[string]any{"firstIndex": j},
 				})
 				continue
 			}
@@ -92,9 +104,10 @@ func (c mapChecker) checkSliceOfMap(fieldName string, arr []map[string]any) gosk
 		if j, dup := seen[comp]; dup {
 			iss = goskema.AppendIssues(iss, goskema.Issue{
 				Path:    "/" + fieldName + "/" + strconv.Itoa(i),
-				Code:    "duplicate_item",
+				Code:    goskema.CodeDuplicateItem,
 				Message: "duplicate element in list-map by keys",
 				Hint:    "first at /" + fieldName + "/" + strconv.Itoa(j),
+				Params:  map[string]any{"firstIndex": j},
 			})
 			continue
 		}
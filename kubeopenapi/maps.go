@@ -3,30 +3,52 @@ package kubeopenapi
 import (
 	"encoding/json"
 
+	goskema "github.com/reoring/goskema"
 	"github.com/reoring/goskema/dsl"
 )
 
-// buildMapAdapterFromAdditional builds a Map[...] AnyAdapter from additionalProperties item schema.
-// Returns (adapter, handled). Handles primitive cases (string, boolean, number/integer) and falls back to MapAny.
-func buildMapAdapterFromAdditional(ap map[string]any, d *simpleDiag) (dsl.AnyAdapter, bool) {
-	if t, _ := ap["type"].(string); t != "" {
-		switch t {
-		case "string":
-			return dsl.MapOf[string](dsl.String()), true
-		case "boolean":
-			return dsl.MapOf[bool](dsl.Bool()), true
-		case "number", "integer":
-			return dsl.MapOf[json.Number](dsl.NumberJSON()), true
-		case "object":
-			// MVP: nested object maps as MapAny (no recursion yet)
-			return dsl.MapOf[map[string]any](dsl.MapAny()), true
-		default:
-			// unknown type → MapAny with warning
-			d.warnf("additionalProperties: unknown item type %q treated as any", t)
-			return dsl.MapOf[map[string]any](dsl.MapAny()), true
-		}
+// buildMapKVAdapter builds a MapKV adapter whose keys are validated by key and whose values follow
+// the item schema type (nil item: any value). minProperties/maxProperties of ps are applied.
+func buildMapKVAdapter(key goskema.Schema[string], item map[string]any, ps map[string]any) dsl.AnyAdapter {
+	if key == nil {
+		key = dsl.String()
 	}
-	// no explicit type → treat as any with warning
-	d.warnf("additionalProperties: schema without type treated as any")
-	return dsl.MapOf[map[string]any](dsl.MapAny()), true
+	t, _ := item["type"].(string)
+	switch t {
+	case "string":
+		return dsl.SchemaOf(withPropCounts(dsl.MapKV(key, goskema.Schema[string](dsl.String())), ps))
+	case "boolean":
+		return dsl.SchemaOf(withPropCounts(dsl.MapKV(key, dsl.Bool()), ps))
+	case "number", "integer":
+		return dsl.SchemaOf(withPropCounts(dsl.MapKV(key, goskema.Schema[json.Number](dsl.NumberJSON())), ps))
+	case "object":
+		return dsl.SchemaOf(withPropCounts(dsl.MapKV(key, dsl.MapAny()), ps))
+	}
+	return dsl.SchemaOf(withPropCounts(dsl.MapKV[string, any](key, nil), ps))
+}
+
+func withPropCounts[V any](mb dsl.MapKVBuilder[string, V], ps map[string]any) dsl.MapKVBuilder[string, V] {
+	if n, ok := intValue(ps["minProperties"]); ok {
+		mb = mb.MinProps(n)
+	}
+	if n, ok := intValue(ps["maxProperties"]); ok {
+		mb = mb.MaxProps(n)
+	}
+	return mb
+}
+
+// intValue reads a non-negative integer keyword decoded from JSON or YAML.
+func intValue(v any) (int, bool) {
+	switch t := v.(type) {
+	case int:
+		return t, t >= 0
+	case int64:
+		return int(t), t >= 0
+	case float64:
+		return int(t), t >= 0 && t == float64(int(t))
+	case json.Number:
+		n, err := t.Int64()
+		return int(n), err == nil && n >= 0
+	}
+	return 0, false
 }
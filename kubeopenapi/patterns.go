@@ -6,84 +6,61 @@ import (
 	"math"
 	"reflect"
 	"regexp"
+	"sort"
+	"strings"
 
 	goskema "github.com/reoring/goskema"
+	"github.com/reoring/goskema/dsl"
 )
 
-// buildKeyPatternRefiner builds an object-level refine that enforces key regex on a map field.
-func buildKeyPatternRefiner(fieldName string, pattern string) func(ctx context.Context, m map[string]any) error {
-	re, err := regexp.Compile(pattern)
-	if err != nil {
-		// Fail closed: invalid regex must report an issue rather than accept everything
-		invalidErr := err
-		return func(ctx context.Context, m map[string]any) error {
-			return goskema.Issues{goskema.Issue{Path: "/" + fieldName, Code: goskema.CodePattern, Message: "invalid regex pattern", Cause: invalidErr}}
+// keyPatternSchema returns a MapKV key schema accepting keys that match any of the patterns.
+// Invalid patterns are returned as an error so that callers can fail closed.
+func keyPatternSchema(patterns []string) (goskema.Schema[string], error) {
+	alts := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		if _, err := regexp.Compile(p); err != nil {
+			return nil, err
 		}
+		alts = append(alts, "(?:"+p+")")
 	}
-	return func(ctx context.Context, m map[string]any) error {
-		v, ok := m[fieldName]
-		if !ok || v == nil {
-			return nil
-		}
-		var iss goskema.Issues
-		// reflect over map[string]T (T varies) to read keys
-		rv := reflect.ValueOf(v)
-		if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
-			for _, mk := range rv.MapKeys() {
-				k := mk.String()
-				if !re.MatchString(k) {
-					iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + fieldName + "/" + k, Code: goskema.CodePattern, Message: "key does not match pattern"})
-				}
-			}
-		}
-		if len(iss) > 0 {
-			return iss
-		}
-		return nil
+	sort.Strings(alts)
+	re, err := regexp.Compile(strings.Join(alts, "|"))
+	if err != nil {
+		return nil, err
 	}
+	return dsl.String().Pattern(re), nil
 }
 
-// buildKeyPatternsRefiner enforces that all keys match at least one of the provided regex patterns.
-func buildKeyPatternsRefiner(fieldName string, patterns []string) func(ctx context.Context, m map[string]any) error {
-	// Precompile; invalid patterns cause a single error at refine time
-	res := make([]*regexp.Regexp, 0, len(patterns))
-	var invalid error
-	for _, p := range patterns {
-		re, err := regexp.Compile(p)
-		if err != nil {
-			invalid = err
-			// keep going to collect other valid patterns but remember the error
-			continue
-		}
-		res = append(res, re)
+// invalidPatternRefiner reports an invalid regex on every parse rather than accepting everything.
+func invalidPatternRefiner(fieldName string, invalid error) func(ctx context.Context, m map[string]any) error {
+	return func(ctx context.Context, m map[string]any) error {
+		return goskema.Issues{goskema.Issue{Path: "/" + fieldName, Code: goskema.CodePattern, Message: "invalid regex pattern", Cause: invalid}}
 	}
+}
+
+// buildKeyRefiner enforces key on the keys of a map or object field using a keys-only MapKV
+// (values are not checked). Any string-keyed map is accepted, including the typed maps
+// (e.g. map[string]string) produced for scalar patternProperties. Issues are reported at
+// /<field>/<key>.
+func buildKeyRefiner(fieldName string, key goskema.Schema[string]) func(ctx context.Context, m map[string]any) error {
+	keys := dsl.MapKV[string, any](key, nil)
 	return func(ctx context.Context, m map[string]any) error {
-		if invalid != nil {
-			return goskema.Issues{goskema.Issue{Path: "/" + fieldName, Code: goskema.CodePattern, Message: "invalid regex pattern", Cause: invalid}}
-		}
-		v, ok := m[fieldName]
-		if !ok || v == nil {
+		rv := reflect.ValueOf(m[fieldName])
+		if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
 			return nil
 		}
-		var iss goskema.Issues
-		rv := reflect.ValueOf(v)
-		if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
-			for _, mk := range rv.MapKeys() {
-				k := mk.String()
-				matched := false
-				for _, re := range res {
-					if re.MatchString(k) {
-						matched = true
-						break
-					}
-				}
-				if !matched {
-					iss = goskema.AppendIssues(iss, goskema.Issue{Path: "/" + fieldName + "/" + k, Code: goskema.CodePattern, Message: "key does not match any allowed pattern"})
-				}
-			}
+		v := make(map[string]any, rv.Len())
+		for _, mk := range rv.MapKeys() {
+			v[mk.String()] = rv.MapIndex(mk).Interface()
 		}
-		if len(iss) > 0 {
-			return iss
+		if err := keys.RuleCheck(ctx, v); err != nil {
+			iss, _ := goskema.AsIssues(err)
+			out := make(goskema.Issues, 0, len(iss))
+			for _, it := range iss {
+				it.Path = "/" + fieldName + it.Path
+				out = append(out, it)
+			}
+			return out
 		}
 		return nil
 	}
@@ -33,29 +33,44 @@ func planObjectProperty(name string, ps map[string]any, d *simpleDiag) (property
 	if t != "object" {
 		return propertyPlan{}, false
 	}
-	// Detect propertyNames (approximation: pattern only)
-	var propNamesRef func(ctx context.Context, m map[string]any) error
+	// Detect propertyNames (approximation: pattern only). Keys are enforced by MapKV key schemas;
+	// invalid regexes fail closed.
+	var (
+		pnKey     goskema.Schema[string]
+		pnInvalid func(ctx context.Context, m map[string]any) error
+	)
 	if pn, ok := ps["propertyNames"].(map[string]any); ok {
 		if patt, _ := pn["pattern"].(string); patt != "" {
-			propNamesRef = buildKeyPatternRefiner(name, patt)
+			k, err := keyPatternSchema([]string{patt})
+			if err != nil {
+				pnInvalid = invalidPatternRefiner(name, err)
+			} else {
+				pnKey = k
+			}
 		}
 	}
+	nullable := func(pp propertyPlan) propertyPlan {
+		if nullableTrue(ps) {
+			pp.adapter = dsl.Nullable(pp.adapter)
+		}
+		return pp
+	}
 	// Nested object with explicit properties: build a nested object adapter recursively.
 	// Keep lenient at this level (do not enforce required) so that minimal spec objects
 	// like spec:{} pass for CRDs that mark nested fields required.
 	if pm, ok := ps["properties"].(map[string]any); ok && len(pm) > 0 {
 		if ad, ok := buildObjectAdapterFromProperties(ps, d); ok {
-			if nullableTrue(ps) {
-				ad = dsl.Nullable(ad)
+			ref := pnInvalid
+			if pnKey != nil {
+				ref = buildKeyRefiner(name, pnKey)
 			}
-			return propertyPlan{name: name, adapter: ad, refine: propNamesRef}, true
+			return nullable(propertyPlan{name: name, adapter: ad, refine: ref}), true
 		}
 	}
 	if ppm, ok := ps["patternProperties"].(map[string]any); ok && len(ppm) >= 1 {
 		// Build adapter and refine under patternProperties with multi-pattern support.
-		// 1) Decide adapter for map values:
-		//    - If all pattern value types are the same and compatible with additionalProperties schema type (if present),
-		//      build Map[...] with that element; otherwise MapAny.
+		// 1) Decide the value schema: if all pattern value types are the same and compatible with
+		//    the additionalProperties schema type (if present), use that type; otherwise any.
 		var (
 			common      map[string]any
 			commonType  string
@@ -78,28 +93,19 @@ func planObjectProperty(name string, ps map[string]any, d *simpleDiag) (property
 				allSameType = false
 			}
 		}
-		var mad dsl.AnyAdapter
-		var apMap map[string]any
-		if apm, ok := ps["additionalProperties"].(map[string]any); ok {
-			apMap = apm
-		}
+		var item map[string]any
 		if allSameType && common != nil {
-			if apMap != nil {
-				apTypeCandidate, _ := apMap["type"].(string)
-				if apTypeCandidate != "" && apTypeCandidate != commonType {
-					mad = dsl.SchemaOf[map[string]any](dsl.MapAny())
-				} else {
-					mad, _ = buildMapAdapterFromAdditional(common, d)
+			item = common
+			if apm, ok := ps["additionalProperties"].(map[string]any); ok {
+				if apt, _ := apm["type"].(string); apt != "" && apt != commonType {
+					item = nil
 				}
-			} else {
-				mad, _ = buildMapAdapterFromAdditional(common, d)
 			}
 		} else {
-			mad = dsl.SchemaOf[map[string]any](dsl.MapAny())
 			d.warnf("patternProperties with heterogeneous value schemas treated as MapAny for values")
 		}
-		// 2) Key enforcement unless additionalProperties permits unmatched
-		var ref func(ctx context.Context, m map[string]any) error
+		// 2) Keys must match a pattern unless additionalProperties permits unmatched keys.
+		//    propertyNames always applies; when both constrain keys, propertyNames is a refine.
 		enforce := true
 		if apb, ok := ps["additionalProperties"].(bool); ok && apb {
 			enforce = false
@@ -107,27 +113,19 @@ func planObjectProperty(name string, ps map[string]any, d *simpleDiag) (property
 		if _, ok := ps["additionalProperties"].(map[string]any); ok {
 			enforce = false
 		}
+		key := pnKey
+		ref := pnInvalid
 		if enforce {
-			if len(patterns) == 1 {
-				ref = buildKeyPatternRefiner(name, patterns[0])
-			} else {
-				ref = buildKeyPatternsRefiner(name, patterns)
+			pk, err := keyPatternSchema(patterns)
+			if err != nil {
+				return nullable(propertyPlan{name: name, adapter: dsl.SchemaOf[map[string]any](dsl.MapAny()), refine: invalidPatternRefiner(name, err)}), true
 			}
-		}
-		// Chain propertyNames refiner first if present
-		if propNamesRef != nil {
-			if ref == nil {
-				ref = propNamesRef
-			} else {
-				keyRef := ref
-				ref = func(ctx context.Context, m map[string]any) error {
-					if err := propNamesRef(ctx, m); err != nil {
-						return err
-					}
-					return keyRef(ctx, m)
-				}
+			key = pk
+			if pnKey != nil {
+				ref = buildKeyRefiner(name, pnKey)
 			}
 		}
+		mad := buildMapKVAdapter(key, item, ps)
 		// 3) Value type refine with multi-pattern semantics
 		var apType string
 		if apm, ok := ps["additionalProperties"].(map[string]any); ok {
@@ -135,7 +133,7 @@ func planObjectProperty(name string, ps map[string]any, d *simpleDiag) (property
 		}
 		vtRef := buildPatternPropertiesValueTypesRefiner(name, patTypeMap, apType)
 		// Chain: key ref (optional) -> value-type ref
-		var chained func(ctx context.Context, m map[string]any) error
+		chained := vtRef
 		if ref != nil {
 			keyRef := ref
 			chained = func(ctx context.Context, m map[string]any) error {
@@ -144,31 +142,20 @@ func planObjectProperty(name string, ps map[string]any, d *simpleDiag) (property
 				}
 				return vtRef(ctx, m)
 			}
-		} else {
-			chained = vtRef
-		}
-		pp := propertyPlan{name: name, adapter: mad, refine: chained}
-		if nullableTrue(ps) {
-			pp.adapter = dsl.Nullable(pp.adapter)
 		}
-		return pp, true
+		return nullable(propertyPlan{name: name, adapter: mad, refine: chained}), true
 	}
 	if ap, ok := ps["additionalProperties"].(map[string]any); ok {
-		ad, handled := buildMapAdapterFromAdditional(ap, d)
-		if nullableTrue(ps) {
-			ad = dsl.Nullable(ad)
-		}
-		if handled {
-			return propertyPlan{name: name, adapter: ad, refine: propNamesRef}, true
+		if t, _ := ap["type"].(string); t == "" {
+			d.warnf("additionalProperties: schema without type treated as any")
+		} else if t != "string" && t != "boolean" && t != "number" && t != "integer" && t != "object" {
+			d.warnf("additionalProperties: unknown item type %q treated as any", t)
 		}
+		return nullable(propertyPlan{name: name, adapter: buildMapKVAdapter(pnKey, ap, ps), refine: pnInvalid}), true
 	}
 	// Fallback: propertyNames only case on an object without properties/patternProperties/additionalProperties.
-	if propNamesRef != nil {
-		ad := dsl.SchemaOf[map[string]any](dsl.MapAny())
-		if nullableTrue(ps) {
-			ad = dsl.Nullable(ad)
-		}
-		return propertyPlan{name: name, adapter: ad, refine: propNamesRef}, true
+	if pnKey != nil || pnInvalid != nil {
+		return nullable(propertyPlan{name: name, adapter: buildMapKVAdapter(pnKey, nil, ps), refine: pnInvalid}), true
 	}
 	return propertyPlan{}, false
 }
@@ -0,0 +1,121 @@
+package goskema
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// OptionalState tells whether an Optional field was missing, null, or set in the input.
+type OptionalState uint8
+
+const (
+	OptionalMissing OptionalState = iota // key absent (zero value)
+	OptionalNull                         // key present with null
+	OptionalSet                          // key present with a value
+)
+
+// Optional carries the presence of a single field in a bound struct, so PATCH handlers can
+// distinguish missing / null / value without consulting PresenceMap by path string.
+//
+//	type UserPatch struct {
+//		Nickname goskema.Optional[string] `json:"nickname,omitzero"`
+//	}
+//
+// dsl.Bind projects missing keys to OptionalMissing, null to OptionalNull and values to
+// OptionalSet (declare the field adapter Nullable to accept null). Encoding back to the wire
+// (ValidateValue, json.Marshal with omitzero) keeps missing fields absent and null fields null.
+type Optional[T any] struct {
+	value T
+	state OptionalState
+}
+
+// Some returns an Optional holding v.
+func Some[T any](v T) Optional[T] { return Optional[T]{value: v, state: OptionalSet} }
+
+// Null returns an Optional that is explicitly null.
+func Null[T any]() Optional[T] { return Optional[T]{state: OptionalNull} }
+
+// State returns the presence state.
+func (o Optional[T]) State() OptionalState { return o.state }
+
+// IsMissing reports whether the field was absent.
+func (o Optional[T]) IsMissing() bool { return o.state == OptionalMissing }
+
+// IsNull reports whether the field was explicitly null.
+func (o Optional[T]) IsNull() bool { return o.state == OptionalNull }
+
+// IsSet reports whether the field holds a value.
+func (o Optional[T]) IsSet() bool { return o.state == OptionalSet }
+
+// Present reports whether the field appeared in the input (null or value).
+func (o Optional[T]) Present() bool { return o.state != OptionalMissing }
+
+// Get returns the value and whether it is set.
+func (o Optional[T]) Get() (T, bool) { return o.value, o.state == OptionalSet }
+
+// OrElse returns the value when set, otherwise def.
+func (o Optional[T]) OrElse(def T) T {
+	if o.state == OptionalSet {
+		return o.value
+	}
+	return def
+}
+
+// IsZero reports a missing field, so that `json:",omitzero"` omits it on encode.
+func (o Optional[T]) IsZero() bool { return o.state == OptionalMissing }
+
+// MarshalJSON encodes a set value as is; missing and null encode as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != OptionalSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON sets the state to null or set (a missing key never reaches UnmarshalJSON).
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// OptionalField is implemented by every Optional[T]. Binders use it to project values without
+// knowing T; *Optional[T] additionally implements SetOptional.
+type OptionalField interface {
+	OptionalElemType() reflect.Type
+	OptionalValue() (any, OptionalState)
+}
+
+// OptionalElemType returns reflect type T.
+func (o Optional[T]) OptionalElemType() reflect.Type { return reflect.TypeOf((*T)(nil)).Elem() }
+
+// OptionalValue returns the value (nil unless set) and the state.
+func (o Optional[T]) OptionalValue() (any, OptionalState) {
+	if o.state != OptionalSet {
+		return nil, o.state
+	}
+	return o.value, o.state
+}
+
+// SetOptional stores v with the given state; v must be a T when state is OptionalSet.
+// It reports false when v has the wrong type.
+func (o *Optional[T]) SetOptional(state OptionalState, v any) bool {
+	if state != OptionalSet {
+		*o = Optional[T]{state: state}
+		return true
+	}
+	tv, ok := v.(T)
+	if !ok {
+		return false
+	}
+	*o = Some(tv)
+	return true
+}
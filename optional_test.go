@@ -0,0 +1,47 @@
+package goskema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	goskema "github.com/reoring/goskema"
+)
+
+type optionalPatch struct {
+	Nickname goskema.Optional[string] `json:"nickname,omitzero"`
+	Age      goskema.Optional[int]    `json:"age,omitzero"`
+	Bio      goskema.Optional[string] `json:"bio,omitzero"`
+}
+
+func TestOptional_States(t *testing.T) {
+	var missing goskema.Optional[string]
+	if !missing.IsMissing() || missing.Present() || missing.OrElse("d") != "d" {
+		t.Fatalf("zero value must be missing: %+v", missing)
+	}
+	null := goskema.Null[string]()
+	if !null.IsNull() || !null.Present() || null.IsSet() {
+		t.Fatalf("unexpected null state: %v", null.State())
+	}
+	set := goskema.Some("x")
+	if v, ok := set.Get(); !ok || v != "x" || set.State() != goskema.OptionalSet {
+		t.Fatalf("unexpected set state: %v %v", v, ok)
+	}
+}
+
+func TestOptional_JSONRoundTrip(t *testing.T) {
+	in := `{"nickname":null,"age":3}`
+	var p optionalPatch
+	if err := json.Unmarshal([]byte(in), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Nickname.IsNull() || p.Age.OrElse(0) != 3 || !p.Bio.IsMissing() {
+		t.Fatalf("unexpected decode: %+v", p)
+	}
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != in {
+		t.Fatalf("round trip mismatch: got %s want %s", out, in)
+	}
+}
@@ -54,17 +54,20 @@ func ParseFromWithMeta[T any](ctx context.Context, s Schema[T], src Source, opts
 		ctx = WithFailFast(ctx, true)
 	}
 	// Avoid running typed rules twice: mark skip for initial Parse used inside schema implementations.
+	outer := ctx
 	ctx = WithSkipTypedRules(ctx, true)
+	ctx, warns := CollectWarnings(ctx)
 	if sp, ok := any(s).(sourceParser[T]); ok {
 		dm, err := sp.ParseFromSourceWithMeta(ctx, src, opt)
 		// apply presence options for consistency with non-streaming path (even when err != nil)
 		dm = applyPresenceToDecoded(dm, opt)
 		if err == nil {
-			return dm, nil
+			return withWarnings(outer, dm, *warns), nil
 		}
 		if !errors.Is(err, ErrStreamingUnsupported) {
-			return dm, toIssues(err)
+			return withWarnings(outer, dm, *warns), toIssues(err)
 		}
+		*warns = nil
 	}
 	v, err := decodeAnyFromSource(src, opt)
 	if err != nil {
@@ -72,7 +75,20 @@ func ParseFromWithMeta[T any](ctx context.Context, s Schema[T], src Source, opts
 	}
 	dm, err := s.ParseWithMeta(ctx, v)
 	dm = applyPresenceToDecoded(dm, opt)
-	return dm, err
+	return withWarnings(outer, dm, *warns), err
+}
+
+// withWarnings attaches collected warnings to dm and forwards them to a collector installed by
+// the caller (CollectWarnings), if any.
+func withWarnings[T any](outer context.Context, dm Decoded[T], warns Issues) Decoded[T] {
+	if len(warns) == 0 {
+		return dm
+	}
+	dm.Warnings = AppendIssues(dm.Warnings, warns...)
+	for _, w := range warns {
+		ReportWarning(outer, w)
+	}
+	return dm
 }
 
 // ---- helpers (parse options, decode, presence, error mapping) ----
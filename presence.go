@@ -1,6 +1,7 @@
 package goskema
 
 import (
+	"context"
 	"strings"
 	"sync"
 )
@@ -12,6 +13,9 @@ const (
 	PresenceSeen           Presence = 1 << iota // Field appeared in the input.
 	PresenceWasNull                             // Field value was null.
 	PresenceDefaultApplied                      // Default value was applied.
+	PresenceTransformed                         // Value was changed by a Preprocess/Transform stage.
+	PresenceCoerced                             // String input was coerced to the field's type.
+	PresenceWriteOnly                           // Field is write-only; preserving encoders drop it.
 )
 
 // PresenceMap maps JSON Pointers to Presence flags.
@@ -21,6 +25,52 @@ type PresenceMap map[string]Presence
 type Decoded[T any] struct {
 	Value    T
 	Presence PresenceMap
+	// Warnings holds warning-severity issues (e.g. deprecated_field) collected by ParseFromWithMeta.
+	Warnings Issues
+}
+
+// presenceSink receives presence recorded by nested schemas. base is the JSON Pointer prefix of
+// the value currently being parsed (see PresenceUnder).
+type presenceSink struct {
+	base string
+	pm   PresenceMap
+}
+
+type presenceSinkKey struct{}
+
+// CollectPresence returns a child context in which nested schemas record the presence of their
+// fields into pm (RecordPresence), e.g. a default applied at "/spec/replicas" while parsing the
+// object at "/spec". Container schemas install it in ParseWithMeta so that Decoded.Presence
+// covers every depth.
+func CollectPresence(ctx context.Context, pm PresenceMap) context.Context {
+	return context.WithValue(ctx, presenceSinkKey{}, &presenceSink{pm: pm})
+}
+
+// CollectsPresence reports whether RecordPresence would record anything for ctx.
+func CollectsPresence(ctx context.Context) bool {
+	_, ok := ctx.Value(presenceSinkKey{}).(*presenceSink)
+	return ok
+}
+
+// PresenceUnder returns a child context whose recorded presence is placed under base
+// (e.g. "/spec"). It returns ctx unchanged when no presence is collected.
+func PresenceUnder(ctx context.Context, base string) context.Context {
+	ps, ok := ctx.Value(presenceSinkKey{}).(*presenceSink)
+	if !ok || base == "" || base == "/" {
+		return ctx
+	}
+	return context.WithValue(ctx, presenceSinkKey{}, &presenceSink{base: ps.base + base, pm: ps.pm})
+}
+
+// RecordPresence ORs p into the presence of path, rebased under the current PresenceUnder
+// prefix. The root path "/" is left to the enclosing container. It is a no-op when ctx does
+// not collect presence.
+func RecordPresence(ctx context.Context, path string, p Presence) {
+	ps, ok := ctx.Value(presenceSinkKey{}).(*presenceSink)
+	if !ok || path == "" || path == "/" {
+		return
+	}
+	ps.pm[ps.base+path] |= p
 }
 
 // simple string interner for PresenceMap keys
@@ -0,0 +1,66 @@
+package goskema
+
+import "context"
+
+type requestKey struct{}
+
+// requestState is the request carried by the context. old is the previous value of the value
+// currently being parsed: *T as given to WithRequest, or the wire shape of a child value once
+// containers narrowed it (WithRequestOld).
+type requestState struct {
+	op  Operation
+	old any
+}
+
+// WithRequest returns a child context carrying req for parsing. Typed rules receive it as
+// DomainCtx.Req, and object schemas apply field access modes with it (read-only fields are not
+// accepted on Create/Update/Patch input, immutable fields may not change against Old).
+//
+//	ctx = goskema.WithRequest(ctx, goskema.RequestInfo[User]{Op: goskema.OpUpdate, Old: &stored})
+func WithRequest[T any](ctx context.Context, req RequestInfo[T]) context.Context {
+	var old any
+	if req.Old != nil {
+		old = req.Old
+	}
+	return context.WithValue(ctx, requestKey{}, requestState{op: req.Op, old: old})
+}
+
+// RequestFrom returns the request installed by WithRequest. Old is set when the previous value
+// in ctx is a T (or *T).
+func RequestFrom[T any](ctx context.Context) (RequestInfo[T], bool) {
+	st, ok := ctx.Value(requestKey{}).(requestState)
+	if !ok {
+		return RequestInfo[T]{}, false
+	}
+	req := RequestInfo[T]{Op: st.op}
+	switch old := st.old.(type) {
+	case *T:
+		req.Old = old
+	case T:
+		req.Old = &old
+	}
+	return req, true
+}
+
+// RequestOp reports the operation installed by WithRequest.
+func RequestOp(ctx context.Context) (Operation, bool) {
+	st, ok := ctx.Value(requestKey{}).(requestState)
+	return st.op, ok
+}
+
+// RequestOld returns the previous value of the value being parsed, or nil.
+func RequestOld(ctx context.Context) any {
+	st, _ := ctx.Value(requestKey{}).(requestState)
+	return st.old
+}
+
+// WithRequestOld returns a child context whose RequestOld is old. Container schemas call it
+// before parsing a child value. It returns ctx unchanged when no request is installed.
+func WithRequestOld(ctx context.Context, old any) context.Context {
+	st, ok := ctx.Value(requestKey{}).(requestState)
+	if !ok {
+		return ctx
+	}
+	st.old = old
+	return context.WithValue(ctx, requestKey{}, st)
+}
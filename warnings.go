@@ -0,0 +1,60 @@
+package goskema
+
+import "context"
+
+// warningSink receives warning-severity issues reported during a parse. base is the JSON
+// Pointer prefix of the value currently being parsed (see WarningsUnder).
+type warningSink struct {
+	base string
+	out  *Issues
+}
+
+type warningSinkKey struct{}
+
+// CollectWarnings returns a child context that records warning-severity issues (for example
+// deprecated_field) reported while parsing, together with the slice they are appended to.
+// Warnings never fail a parse. ParseFromWithMeta collects them into Decoded.Warnings on its own.
+//
+//	ctx, warns := goskema.CollectWarnings(ctx)
+//	v, err := s.Parse(ctx, input)
+//	for _, w := range *warns { log.Print(w.Path, w.Code) }
+func CollectWarnings(ctx context.Context) (context.Context, *Issues) {
+	out := &Issues{}
+	return context.WithValue(ctx, warningSinkKey{}, &warningSink{out: out}), out
+}
+
+// CollectsWarnings reports whether ReportWarning would record anything for ctx, so that
+// schema implementations can skip building warnings nobody reads.
+func CollectsWarnings(ctx context.Context) bool {
+	_, ok := ctx.Value(warningSinkKey{}).(*warningSink)
+	return ok
+}
+
+// WarningsUnder returns a child context whose reported warnings are placed under base
+// (e.g. "/spec"). Container schemas call it before parsing a child value. It returns ctx
+// unchanged when no warnings are collected.
+func WarningsUnder(ctx context.Context, base string) context.Context {
+	ws, ok := ctx.Value(warningSinkKey{}).(*warningSink)
+	if !ok || base == "" || base == "/" {
+		return ctx
+	}
+	return context.WithValue(ctx, warningSinkKey{}, &warningSink{base: ws.base + base, out: ws.out})
+}
+
+// ReportWarning records it with Severity Warn when ctx collects warnings; the path is rebased
+// under the current WarningsUnder prefix. It is a no-op otherwise.
+func ReportWarning(ctx context.Context, it Issue) {
+	ws, ok := ctx.Value(warningSinkKey{}).(*warningSink)
+	if !ok {
+		return
+	}
+	it.Severity = Warn
+	if ws.base != "" {
+		if it.Path == "" || it.Path == "/" {
+			it.Path = ws.base
+		} else {
+			it.Path = ws.base + it.Path
+		}
+	}
+	*ws.out = AppendIssues(*ws.out, it)
+}